@@ -45,9 +45,9 @@ func (d *Dumper) DumpNode(node Node, level int) {
 	case *VariableDeclarator:
 		d.printIndent(level)
 		d.append("VariableDeclarator[\n")
-		d.printIndent(level + 1)
-		d.append(n.Id.Name)
+		d.DumpNode(n.Id, level+1)
 		if n.Init != nil {
+			d.printIndent(level + 1)
 			d.append("=")
 			d.DumpNode(n.Init, level+1)
 		}
@@ -83,7 +83,26 @@ func (d *Dumper) DumpNode(node Node, level int) {
 		d.printIndent(level)
 		d.append("CallExpression[\n")
 		d.printIndent(level + 1)
-		d.append(n.Callee.Name)
+		d.append("callee=")
+		d.DumpNode(n.Callee, level+1)
+		d.printIndent(level + 1)
+		d.append("(\n")
+		for i, arg := range n.Arguments {
+			d.printIndent(level + 2)
+			d.append(fmt.Sprintf("arg%d=", i))
+			d.DumpNode(arg, level+2)
+		}
+		d.printIndent(level + 1)
+		d.append(")\n")
+		d.printIndent(level)
+		d.append("]\n")
+	case *NewExpression:
+		d.printIndent(level)
+		d.append("NewExpression[\n")
+		d.printIndent(level + 1)
+		d.append("callee=")
+		d.DumpNode(n.Callee, level+1)
+		d.printIndent(level + 1)
 		d.append("(\n")
 		for i, arg := range n.Arguments {
 			d.printIndent(level + 2)
@@ -94,6 +113,40 @@ func (d *Dumper) DumpNode(node Node, level int) {
 		d.append(")\n")
 		d.printIndent(level)
 		d.append("]\n")
+	case *ConditionalExpression:
+		d.printIndent(level)
+		d.append("ConditionalExpression[\n")
+		d.printIndent(level + 1)
+		d.append("test=")
+		d.DumpNode(n.Test, level+1)
+		d.printIndent(level + 1)
+		d.append("consequent=")
+		d.DumpNode(n.Consequent, level+1)
+		d.printIndent(level + 1)
+		d.append("alternate=")
+		d.DumpNode(n.Alternate, level+1)
+		d.printIndent(level)
+		d.append("]\n")
+	case *SequenceExpression:
+		d.printIndent(level)
+		d.append("SequenceExpression[\n")
+		for _, e := range n.Expressions {
+			d.DumpNode(e, level+1)
+		}
+		d.printIndent(level)
+		d.append("]\n")
+	case *ParenthesizedExpression:
+		d.printIndent(level)
+		d.append("ParenthesizedExpression[\n")
+		d.DumpNode(n.Expression, level+1)
+		d.printIndent(level)
+		d.append("]\n")
+	case *ChainExpression:
+		d.printIndent(level)
+		d.append("ChainExpression[\n")
+		d.DumpNode(n.Expression, level+1)
+		d.printIndent(level)
+		d.append("]\n")
 	case *BinaryExpression:
 		d.printIndent(level)
 		d.append("BinaryExpression[\n")
@@ -109,10 +162,86 @@ func (d *Dumper) DumpNode(node Node, level int) {
 		d.DumpNode(n.Right, level+1)
 		d.printIndent(level)
 		d.append("]\n")
-	case *IntLiteral:
+	case *LogicalExpression:
+		d.printIndent(level)
+		d.append("LogicalExpression[\n")
+		d.printIndent(level + 1)
+		d.append("lhs=")
+		d.DumpNode(n.Left, level+1)
+		d.printIndent(level + 1)
+		d.append("op=(" + n.Operator + ")\n")
+		d.printIndent(level + 1)
+		d.append("rhs=")
+		d.DumpNode(n.Right, level+1)
+		d.printIndent(level)
+		d.append("]\n")
+	case *UnaryExpression:
+		d.printIndent(level)
+		d.append("UnaryExpression[\n")
+		d.printIndent(level + 1)
+		d.append("op=(" + n.Operator + ")\n")
+		d.printIndent(level + 1)
+		d.append("arg=")
+		d.DumpNode(n.Argument, level+1)
+		d.printIndent(level)
+		d.append("]\n")
+	case *UpdateExpression:
+		d.printIndent(level)
+		d.append("UpdateExpression[\n")
+		d.printIndent(level + 1)
+		d.append("op=(" + n.Operator + ")\n")
+		d.printIndent(level + 1)
+		d.append("prefix=" + strconv.FormatBool(n.Prefix) + "\n")
+		d.printIndent(level + 1)
+		d.append("arg=")
+		d.DumpNode(n.Argument, level+1)
+		d.printIndent(level)
+		d.append("]\n")
+	case *NumericLiteral:
 		d.printIndent(level)
-		d.append(strconv.Itoa(n.Value))
+		d.append(strconv.FormatFloat(n.Value, 'g', -1, 64))
 		d.append("\n")
+	case *BigIntLiteral:
+		d.printIndent(level)
+		d.append(n.Value.String() + "n")
+		d.append("\n")
+	case *BooleanLiteral:
+		d.printIndent(level)
+		d.append(strconv.FormatBool(n.Value))
+		d.append("\n")
+	case *NullLiteral:
+		d.printIndent(level)
+		d.append("null")
+		d.append("\n")
+	case *StringLiteral:
+		d.printIndent(level)
+		d.append(strconv.Quote(n.Value))
+		d.append("\n")
+	case *RegExpLiteral:
+		d.printIndent(level)
+		d.append("/" + n.Pattern + "/" + n.Flags)
+		d.append("\n")
+	case *TemplateLiteral:
+		d.printIndent(level)
+		d.append("TemplateLiteral[\n")
+		for i, q := range n.Quasis {
+			d.printIndent(level + 1)
+			d.append(strconv.Quote(q.Raw) + "\n")
+			if i < len(n.Expressions) {
+				d.DumpNode(n.Expressions[i], level+1)
+			}
+		}
+		d.printIndent(level)
+		d.append("]\n")
+	case *TaggedTemplateExpression:
+		d.printIndent(level)
+		d.append("TaggedTemplateExpression[\n")
+		d.printIndent(level + 1)
+		d.append("tag=")
+		d.DumpNode(n.Tag, level+1)
+		d.DumpNode(n.Quasi, level+1)
+		d.printIndent(level)
+		d.append("]\n")
 	case *AssignmentExpression:
 		d.printIndent(level)
 		d.append("AssignmentExpression[\n")
@@ -126,6 +255,152 @@ func (d *Dumper) DumpNode(node Node, level int) {
 		d.DumpNode(n.Right, level+1)
 		d.printIndent(level)
 		d.append("]\n")
+	case *ArrayExpression:
+		d.printIndent(level)
+		d.append("ArrayExpression[\n")
+		for _, e := range n.Elements {
+			if e == nil {
+				d.printIndent(level + 1)
+				d.append("<hole>\n")
+				continue
+			}
+			d.DumpNode(e, level+1)
+		}
+		d.printIndent(level)
+		d.append("]\n")
+	case *ObjectExpression:
+		d.printIndent(level)
+		d.append("ObjectExpression[\n")
+		for _, p := range n.Properties {
+			d.DumpNode(p, level+1)
+		}
+		d.printIndent(level)
+		d.append("]\n")
+	case *Property:
+		d.printIndent(level)
+		d.append("Property[" + n.Kind + "\n")
+		d.printIndent(level + 1)
+		d.append("key=")
+		d.DumpNode(n.Key, level+1)
+		d.printIndent(level + 1)
+		d.append("value=")
+		d.DumpNode(n.Value, level+1)
+		d.printIndent(level)
+		d.append("]\n")
+	case *SpreadElement:
+		d.printIndent(level)
+		d.append("SpreadElement[\n")
+		d.DumpNode(n.Argument, level+1)
+		d.printIndent(level)
+		d.append("]\n")
+	case *FunctionExpression:
+		d.printIndent(level)
+		d.append("FunctionExpression[\n")
+		d.DumpNode(n.Body, level+1)
+		d.printIndent(level)
+		d.append("]\n")
+	case *ObjectPattern:
+		d.printIndent(level)
+		d.append("ObjectPattern[\n")
+		for _, p := range n.Properties {
+			d.DumpNode(p, level+1)
+		}
+		d.printIndent(level)
+		d.append("]\n")
+	case *ArrayPattern:
+		d.printIndent(level)
+		d.append("ArrayPattern[\n")
+		for _, e := range n.Elements {
+			if e == nil {
+				d.printIndent(level + 1)
+				d.append("<hole>\n")
+				continue
+			}
+			d.DumpNode(e, level+1)
+		}
+		d.printIndent(level)
+		d.append("]\n")
+	case *AssignmentPattern:
+		d.printIndent(level)
+		d.append("AssignmentPattern[\n")
+		d.DumpNode(n.Left, level+1)
+		d.DumpNode(n.Right, level+1)
+		d.printIndent(level)
+		d.append("]\n")
+	case *RestElement:
+		d.printIndent(level)
+		d.append("RestElement[\n")
+		d.DumpNode(n.Argument, level+1)
+		d.printIndent(level)
+		d.append("]\n")
+	case *WithStatement:
+		d.printIndent(level)
+		d.append("WithStatement[\n")
+		d.printIndent(level + 1)
+		d.append("object=")
+		d.DumpNode(n.Object, level+1)
+		d.DumpNode(n.Body, level+1)
+		d.printIndent(level)
+		d.append("]\n")
+	case *ThisExpression:
+		d.printIndent(level)
+		d.append("this\n")
+	case *ClassDeclaration:
+		d.printIndent(level)
+		d.append("ClassDeclaration[\n")
+		d.dumpClass(n.Id, n.SuperClass, n.Body, level+1)
+		d.printIndent(level)
+		d.append("]\n")
+	case *ClassExpression:
+		d.printIndent(level)
+		d.append("ClassExpression[\n")
+		d.dumpClass(n.Id, n.SuperClass, n.Body, level+1)
+		d.printIndent(level)
+		d.append("]\n")
+	case *MethodDefinition:
+		d.printIndent(level)
+		d.append("MethodDefinition[" + n.Kind)
+		if n.Static {
+			d.append(" static")
+		}
+		d.append("\n")
+		d.printIndent(level + 1)
+		d.append("key=")
+		d.DumpNode(n.Key, level+1)
+		d.DumpNode(n.Value, level+1)
+		d.printIndent(level)
+		d.append("]\n")
+	case *PropertyDefinition:
+		d.printIndent(level)
+		d.append("PropertyDefinition[")
+		if n.Static {
+			d.append("static")
+		}
+		d.append("\n")
+		d.printIndent(level + 1)
+		d.append("key=")
+		d.DumpNode(n.Key, level+1)
+		if n.Value != nil {
+			d.printIndent(level + 1)
+			d.append("value=")
+			d.DumpNode(n.Value, level+1)
+		}
+		d.printIndent(level)
+		d.append("]\n")
+	case *StaticBlock:
+		d.printIndent(level)
+		d.append("StaticBlock[\n")
+		for _, s := range n.Body {
+			d.DumpNode(s, level+1)
+		}
+		d.printIndent(level)
+		d.append("]\n")
+	case *PrivateIdentifier:
+		d.printIndent(level)
+		d.append("PrivateIdentifier[#" + n.Name + "]\n")
+	case *Super:
+		d.printIndent(level)
+		d.append("super\n")
 	case *ForStatement:
 		d.printIndent(level)
 		d.append("ForStatement[\n")
@@ -140,6 +415,54 @@ func (d *Dumper) DumpNode(node Node, level int) {
 		d.DumpNode(n.Update, level+1)
 		d.printIndent(level)
 		d.append("]\n")
+	case *ForInStatement:
+		d.printIndent(level)
+		d.append("ForInStatement[\n")
+		d.dumpForInOf(n.Left, n.Right, n.Body, level+1)
+		d.printIndent(level)
+		d.append("]\n")
+	case *ForOfStatement:
+		d.printIndent(level)
+		d.append("ForOfStatement[\n")
+		d.dumpForInOf(n.Left, n.Right, n.Body, level+1)
+		d.printIndent(level)
+		d.append("]\n")
+	case *YieldExpression:
+		d.printIndent(level)
+		d.append("YieldExpression[\n")
+		d.printIndent(level + 1)
+		d.append("delegate=" + strconv.FormatBool(n.Delegate) + "\n")
+		if n.Argument != nil {
+			d.printIndent(level + 1)
+			d.append("arg=")
+			d.DumpNode(n.Argument, level+1)
+		}
+		d.printIndent(level)
+		d.append("]\n")
+	}
+}
+
+func (d *Dumper) dumpForInOf(left Node, right Expression, body Statement, level int) {
+	d.printIndent(level)
+	d.append("left=")
+	d.DumpNode(left, level)
+	d.printIndent(level)
+	d.append("right=")
+	d.DumpNode(right, level)
+	d.DumpNode(body, level)
+}
+
+func (d *Dumper) dumpClass(id *Identifier, superClass Expression, body *ClassBody, level int) {
+	if id != nil {
+		d.DumpNode(id, level)
+	}
+	if superClass != nil {
+		d.printIndent(level)
+		d.append("extends=")
+		d.DumpNode(superClass, level)
+	}
+	for _, element := range body.Body {
+		d.DumpNode(element, level)
 	}
 }
 
@@ -1,5 +1,7 @@
 package ast
 
+import "math/big"
+
 type Node interface {
 	Node()
 }
@@ -14,13 +16,40 @@ type Expression interface {
 	_Expression()
 }
 
+// Pattern is the target of a binding or of a destructuring assignment.
+// Patterns are also expressions, as assignment patterns are parsed as
+// object and array literals first.
+// https://tc39.es/ecma262/#sec-destructuring-binding-patterns
+// https://tc39.es/ecma262/#sec-destructuring-assignment
+type Pattern interface {
+	Node()
+	_Expression()
+	_Pattern()
+}
+
+// Strict is set when the program is strict mode code, either because its
+// directive prologue has a "use strict" directive or because the parser was
+// asked to parse strict mode code. Comments are the comments of the source
+// text in order, which are only known when the tokens carry their trivia.
 type Program struct {
 	Start, End int
 	Body       []Node
+	Strict     bool
+	Comments   []Comment
 }
 
 func (p *Program) Node() {}
 
+// Comment is a comment of the source text, which is not part of the syntax
+// tree. Text is its text without the delimiters, and Multiline is set for
+// comments delimited by /* and */.
+// https://tc39.es/ecma262/#sec-comments
+type Comment struct {
+	Start, End int
+	Text       string
+	Multiline  bool
+}
+
 type Identifier struct {
 	Start, End int
 	Name       string
@@ -29,13 +58,22 @@ type Identifier struct {
 func (i *Identifier) Node()        {}
 func (i *Identifier) _Statement()  {}
 func (i *Identifier) _Expression() {}
+func (i *Identifier) _Pattern()    {}
 
 type FunctionDeclaration struct {
 	Start, End                   int
 	Expression, Generator, Async bool
 	Id                           Identifier
-	Parameters                   []Identifier
+	Parameters                   []Pattern
 	Body                         Statement
+
+	// Strict is set when the function code is strict mode code.
+	Strict bool
+
+	// SourceText is the source matched by the declaration. Parsed from tokens
+	// scanned in advance without the source, it is rebuilt from the tokens
+	// and their trivia, or single separators when they carry none.
+	SourceText string
 }
 
 func (f *FunctionDeclaration) Node()       {}
@@ -60,12 +98,14 @@ func (v *VariableDeclaration) _Statement() {}
 
 type VariableDeclarator struct {
 	Start, End int
-	Id         *Identifier
+	Id         Pattern
 	Init       Expression
 }
 
 func (v *VariableDeclarator) Node() {}
 
+// Elements holds nil for every elision, and a *SpreadElement for spread
+// elements.
 type ArrayExpression struct {
 	Start, End int
 	Elements   []Expression
@@ -78,10 +118,13 @@ type MemberExpression struct {
 	Start, End int
 	Object     Expression
 	Property   Expression
+	Computed   bool
+	Optional   bool
 }
 
 func (m *MemberExpression) Node()        {}
 func (m *MemberExpression) _Expression() {}
+func (m *MemberExpression) _Pattern()    {}
 
 type BinaryExpression struct {
 	Start, End  int
@@ -92,9 +135,29 @@ type BinaryExpression struct {
 func (b *BinaryExpression) Node()        {}
 func (b *BinaryExpression) _Expression() {}
 
+type LogicalExpression struct {
+	Start, End  int
+	Left, Right Expression
+	Operator    string
+}
+
+func (l *LogicalExpression) Node()        {}
+func (l *LogicalExpression) _Expression() {}
+
+type UnaryExpression struct {
+	Start, End int
+	Argument   Expression
+	Operator   string
+}
+
+func (u *UnaryExpression) Node()        {}
+func (u *UnaryExpression) _Expression() {}
+
 type UpdateExpression struct {
-	Argument Expression
-	Operator string
+	Start, End int
+	Argument   Expression
+	Operator   string
+	Prefix     bool
 }
 
 func (u *UpdateExpression) Node()        {}
@@ -127,7 +190,7 @@ func (e *ExpressionStatement) _Statement() {}
 
 type CallExpression struct {
 	Start, End int
-	Callee     *Identifier
+	Callee     Expression
 	Arguments  []Expression
 	Optional   bool
 }
@@ -135,13 +198,111 @@ type CallExpression struct {
 func (c *CallExpression) Node()        {}
 func (c *CallExpression) _Expression() {}
 
-type IntLiteral struct {
+// https://tc39.es/ecma262/#prod-ConditionalExpression
+type ConditionalExpression struct {
+	Start, End                  int
+	Test, Consequent, Alternate Expression
+}
+
+func (c *ConditionalExpression) Node()        {}
+func (c *ConditionalExpression) _Expression() {}
+
+// SequenceExpression evaluates the comma separated expressions in order,
+// producing the value of the last one.
+// https://tc39.es/ecma262/#sec-comma-operator
+type SequenceExpression struct {
+	Start, End  int
+	Expressions []Expression
+}
+
+func (s *SequenceExpression) Node()        {}
+func (s *SequenceExpression) _Expression() {}
+
+// ParenthesizedExpression keeps the parentheses around an expression. It is
+// only a valid assignment target when it wraps a simple one, as in (a) = 1.
+// https://tc39.es/ecma262/#prod-ParenthesizedExpression
+type ParenthesizedExpression struct {
+	Start, End int
+	Expression Expression
+}
+
+func (p *ParenthesizedExpression) Node()        {}
+func (p *ParenthesizedExpression) _Expression() {}
+func (p *ParenthesizedExpression) _Pattern()    {}
+
+// https://tc39.es/ecma262/#prod-NewExpression
+type NewExpression struct {
 	Start, End int
-	Value      int
+	Callee     Expression
+	Arguments  []Expression
 }
 
-func (i *IntLiteral) Node()        {}
-func (i *IntLiteral) _Expression() {}
+func (n *NewExpression) Node()        {}
+func (n *NewExpression) _Expression() {}
+
+// ChainExpression wraps an optional chain. The whole chain evaluates to
+// undefined as soon as the base of an optional member or call is null or
+// undefined.
+// https://tc39.es/ecma262/#prod-OptionalExpression
+type ChainExpression struct {
+	Start, End int
+	Expression Expression
+}
+
+func (c *ChainExpression) Node()        {}
+func (c *ChainExpression) _Expression() {}
+
+type NumericLiteral struct {
+	Start, End int
+	Value      float64
+}
+
+func (n *NumericLiteral) Node()        {}
+func (n *NumericLiteral) _Expression() {}
+
+// https://tc39.es/ecma262/#prod-BigIntLiteral
+type BigIntLiteral struct {
+	Start, End int
+	Value      *big.Int
+}
+
+func (n *BigIntLiteral) Node()        {}
+func (n *BigIntLiteral) _Expression() {}
+
+// https://tc39.es/ecma262/#prod-BooleanLiteral
+type BooleanLiteral struct {
+	Start, End int
+	Value      bool
+}
+
+func (n *BooleanLiteral) Node()        {}
+func (n *BooleanLiteral) _Expression() {}
+
+// https://tc39.es/ecma262/#prod-NullLiteral
+type NullLiteral struct {
+	Start, End int
+}
+
+func (n *NullLiteral) Node()        {}
+func (n *NullLiteral) _Expression() {}
+
+type StringLiteral struct {
+	Start, End int
+	Value      string
+}
+
+func (s *StringLiteral) Node()        {}
+func (s *StringLiteral) _Expression() {}
+
+// https://tc39.es/ecma262/#sec-literals-regular-expression-literals
+type RegExpLiteral struct {
+	Start, End int
+	Pattern    string
+	Flags      string
+}
+
+func (r *RegExpLiteral) Node()        {}
+func (r *RegExpLiteral) _Expression() {}
 
 type ForStatement struct {
 	Start, End   int
@@ -153,6 +314,48 @@ type ForStatement struct {
 func (f *ForStatement) Node()       {}
 func (f *ForStatement) _Statement() {}
 
+// Left is the *VariableDeclaration or Pattern each key is bound to.
+// https://tc39.es/ecma262/#prod-ForInOfStatement
+type ForInStatement struct {
+	Start, End int
+	Left       Node
+	Right      Expression
+	Body       Statement
+}
+
+func (f *ForInStatement) Node()       {}
+func (f *ForInStatement) _Statement() {}
+
+// Left is the *VariableDeclaration or Pattern each value is bound to.
+// https://tc39.es/ecma262/#prod-ForInOfStatement
+type ForOfStatement struct {
+	Start, End int
+	Left       Node
+	Right      Expression
+	Body       Statement
+}
+
+func (f *ForOfStatement) Node()       {}
+func (f *ForOfStatement) _Statement() {}
+
+// https://tc39.es/ecma262/#prod-WithStatement
+type WithStatement struct {
+	Start, End int
+	Object     Expression
+	Body       Statement
+}
+
+func (w *WithStatement) Node()       {}
+func (w *WithStatement) _Statement() {}
+
+// https://tc39.es/ecma262/#sec-this-keyword
+type ThisExpression struct {
+	Start, End int
+}
+
+func (t *ThisExpression) Node()        {}
+func (t *ThisExpression) _Expression() {}
+
 type AssignmentExpression struct {
 	Start, End  int
 	Operator    string
@@ -164,16 +367,265 @@ func (a *AssignmentExpression) _Expression() {}
 
 type ObjectExpression struct {
 	Start, End int
-	Properties []Property
+
+	// Properties holds a *Property or a *SpreadElement for every entry.
+	Properties []Node
 }
 
 func (o *ObjectExpression) Node()        {}
 func (o *ObjectExpression) _Expression() {}
 
+// Property is a property definition of an object literal. Kind is "init" for
+// data properties and methods, and "get" or "set" for accessors, whose Value
+// is then a *FunctionExpression. Shorthand properties have an *Identifier key
+// and value.
+// https://tc39.es/ecma262/#prod-PropertyDefinition
 type Property struct {
 	Start, End int
 	Key        Expression
 	Value      Expression
+	Kind       string
+
+	Computed, Method, Shorthand bool
 }
 
 func (p *Property) Node() {}
+
+// IsProtoSetter reports whether the property definition sets the prototype
+// of the object instead of defining a property.
+// https://tc39.es/ecma262/#sec-runtime-semantics-propertydefinitionevaluation
+func (p *Property) IsProtoSetter() bool {
+	if p.Computed || p.Shorthand || p.Method || p.Kind != "init" {
+		return false
+	}
+
+	switch key := p.Key.(type) {
+	case *Identifier:
+		return key.Name == "__proto__"
+	case *StringLiteral:
+		return key.Value == "__proto__"
+	default:
+		return false
+	}
+}
+
+// https://tc39.es/ecma262/#prod-SpreadElement
+type SpreadElement struct {
+	Start, End int
+	Argument   Expression
+}
+
+func (s *SpreadElement) Node()        {}
+func (s *SpreadElement) _Expression() {}
+
+// https://tc39.es/ecma262/#prod-FunctionExpression
+// https://tc39.es/ecma262/#prod-MethodDefinition
+type FunctionExpression struct {
+	Start, End       int
+	Generator, Async bool
+	Id               *Identifier
+	Parameters       []Pattern
+	Body             Statement
+
+	// Strict is set when the function code is strict mode code.
+	Strict bool
+
+	// SourceText is the source matched by the expression. Parsed from tokens
+	// scanned in advance without the source, it is rebuilt from the tokens
+	// and their trivia, or single separators when they carry none.
+	SourceText string
+}
+
+func (f *FunctionExpression) Node()        {}
+func (f *FunctionExpression) _Expression() {}
+
+type TemplateLiteral struct {
+	Start, End  int
+	Quasis      []TemplateElement
+	Expressions []Expression
+}
+
+func (t *TemplateLiteral) Node()        {}
+func (t *TemplateLiteral) _Expression() {}
+
+type TemplateElement struct {
+	Start, End int
+	Raw        string
+
+	// Cooked is the template value of the element. HasCooked is false when
+	// the element of a tagged template contains an invalid escape sequence.
+	Cooked    string
+	HasCooked bool
+	Tail      bool
+}
+
+func (t *TemplateElement) Node() {}
+
+type TaggedTemplateExpression struct {
+	Start, End int
+	Tag        Expression
+	Quasi      *TemplateLiteral
+}
+
+func (t *TaggedTemplateExpression) Node()        {}
+func (t *TaggedTemplateExpression) _Expression() {}
+
+// Properties holds a *Property, whose value is a pattern, or a *RestElement
+// for every entry.
+// https://tc39.es/ecma262/#prod-ObjectBindingPattern
+// https://tc39.es/ecma262/#prod-ObjectAssignmentPattern
+type ObjectPattern struct {
+	Start, End int
+	Properties []Node
+}
+
+func (o *ObjectPattern) Node()        {}
+func (o *ObjectPattern) _Expression() {}
+func (o *ObjectPattern) _Pattern()    {}
+
+// Elements holds nil for every elision.
+// https://tc39.es/ecma262/#prod-ArrayBindingPattern
+// https://tc39.es/ecma262/#prod-ArrayAssignmentPattern
+type ArrayPattern struct {
+	Start, End int
+	Elements   []Pattern
+}
+
+func (a *ArrayPattern) Node()        {}
+func (a *ArrayPattern) _Expression() {}
+func (a *ArrayPattern) _Pattern()    {}
+
+// AssignmentPattern is a target with a default value, which is used when the
+// destructured value is undefined.
+// https://tc39.es/ecma262/#prod-SingleNameBinding
+type AssignmentPattern struct {
+	Start, End int
+	Left       Pattern
+	Right      Expression
+}
+
+func (a *AssignmentPattern) Node()        {}
+func (a *AssignmentPattern) _Expression() {}
+func (a *AssignmentPattern) _Pattern()    {}
+
+// https://tc39.es/ecma262/#prod-BindingRestElement
+// https://tc39.es/ecma262/#prod-BindingRestProperty
+type RestElement struct {
+	Start, End int
+	Argument   Pattern
+}
+
+func (r *RestElement) Node()        {}
+func (r *RestElement) _Expression() {}
+func (r *RestElement) _Pattern()    {}
+
+// https://tc39.es/ecma262/#prod-ClassDeclaration
+type ClassDeclaration struct {
+	Start, End int
+	Id         *Identifier
+	SuperClass Expression
+	Body       *ClassBody
+
+	// SourceText is the source matched by the declaration. Parsed from tokens
+	// scanned in advance without the source, it is rebuilt from the tokens
+	// and their trivia, or single separators when they carry none.
+	SourceText string
+}
+
+func (c *ClassDeclaration) Node()       {}
+func (c *ClassDeclaration) _Statement() {}
+
+// https://tc39.es/ecma262/#prod-ClassExpression
+type ClassExpression struct {
+	Start, End int
+	Id         *Identifier
+	SuperClass Expression
+	Body       *ClassBody
+
+	// SourceText is the source matched by the expression. Parsed from tokens
+	// scanned in advance without the source, it is rebuilt from the tokens
+	// and their trivia, or single separators when they carry none.
+	SourceText string
+}
+
+func (c *ClassExpression) Node()        {}
+func (c *ClassExpression) _Expression() {}
+
+// Body holds a *MethodDefinition, *PropertyDefinition or *StaticBlock for
+// every class element.
+// https://tc39.es/ecma262/#prod-ClassBody
+type ClassBody struct {
+	Start, End int
+	Body       []Node
+}
+
+func (c *ClassBody) Node() {}
+
+// MethodDefinition is a method or accessor of a class. Kind is
+// "constructor", "method", "get" or "set". The key is a *PrivateIdentifier
+// for private methods.
+// https://tc39.es/ecma262/#prod-MethodDefinition
+type MethodDefinition struct {
+	Start, End int
+	Key        Expression
+	Value      *FunctionExpression
+	Kind       string
+
+	Computed, Static bool
+}
+
+func (m *MethodDefinition) Node() {}
+
+// PropertyDefinition is a field of a class, whose Value is nil when it has
+// no initializer.
+// https://tc39.es/ecma262/#prod-FieldDefinition
+type PropertyDefinition struct {
+	Start, End int
+	Key        Expression
+	Value      Expression
+
+	Computed, Static bool
+}
+
+func (p *PropertyDefinition) Node() {}
+
+// https://tc39.es/ecma262/#prod-ClassStaticBlock
+type StaticBlock struct {
+	Start, End int
+	Body       []Statement
+}
+
+func (s *StaticBlock) Node() {}
+
+// PrivateIdentifier is a private name, as in #x. Name excludes the #.
+// https://tc39.es/ecma262/#prod-PrivateIdentifier
+type PrivateIdentifier struct {
+	Start, End int
+	Name       string
+}
+
+func (p *PrivateIdentifier) Node()        {}
+func (p *PrivateIdentifier) _Expression() {}
+
+// Super is the super keyword, which is the callee of a super call or the
+// object of a super property access.
+// https://tc39.es/ecma262/#prod-SuperCall
+// https://tc39.es/ecma262/#prod-SuperProperty
+type Super struct {
+	Start, End int
+}
+
+func (s *Super) Node()        {}
+func (s *Super) _Expression() {}
+
+// YieldExpression suspends a generator. Delegate is set for yield*, which
+// yields each value of the iterable argument in turn.
+// https://tc39.es/ecma262/#prod-YieldExpression
+type YieldExpression struct {
+	Start, End int
+	Argument   Expression
+	Delegate   bool
+}
+
+func (y *YieldExpression) Node()        {}
+func (y *YieldExpression) _Expression() {}
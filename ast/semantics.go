@@ -0,0 +1,173 @@
+package ast
+
+// BoundNames returns the names bound by the pattern, in source order.
+// https://tc39.es/ecma262/#sec-static-semantics-boundnames
+func BoundNames(pattern Pattern) []string {
+	switch p := pattern.(type) {
+	case *Identifier:
+		return []string{p.Name}
+	case *AssignmentPattern:
+		return BoundNames(p.Left)
+	case *RestElement:
+		return BoundNames(p.Argument)
+	case *ArrayPattern:
+		var names []string
+		for _, e := range p.Elements {
+			if e != nil {
+				names = append(names, BoundNames(e)...)
+			}
+		}
+		return names
+	case *ObjectPattern:
+		var names []string
+		for _, property := range p.Properties {
+			switch property := property.(type) {
+			case *Property:
+				names = append(names, BoundNames(property.Value.(Pattern))...)
+			case *RestElement:
+				names = append(names, BoundNames(property)...)
+			}
+		}
+		return names
+	default:
+		return nil
+	}
+}
+
+// LexicallyDeclaredNames returns the names a statement declares in the
+// statement list it is part of with let, const, class and function
+// declarations. Functions declared at the top level of a function or script
+// are var declarations instead.
+// https://tc39.es/ecma262/#sec-static-semantics-lexicallydeclarednames
+// https://tc39.es/ecma262/#sec-static-semantics-toplevellexicallydeclarednames
+func LexicallyDeclaredNames(statement Statement, topLevel bool) []string {
+	switch s := statement.(type) {
+	case *VariableDeclaration:
+		if s.Kind != "var" {
+			return VarDeclaredNames(&VariableDeclaration{Declarations: s.Declarations, Kind: "var"}, false)
+		}
+	case *ClassDeclaration:
+		return []string{s.Id.Name}
+	case *FunctionDeclaration:
+		if !topLevel {
+			return []string{s.Id.Name}
+		}
+	}
+	return nil
+}
+
+// VarDeclaredNames returns the names a statement declares with var
+// declarations, including those nested in its statements but not in nested
+// functions and classes. Functions declared at the top level of a function
+// or script are included.
+// https://tc39.es/ecma262/#sec-static-semantics-vardeclarednames
+// https://tc39.es/ecma262/#sec-static-semantics-toplevelvardeclarednames
+func VarDeclaredNames(statement Statement, topLevel bool) []string {
+	var names []string
+	switch s := statement.(type) {
+	case *VariableDeclaration:
+		if s.Kind == "var" {
+			for _, d := range s.Declarations {
+				names = append(names, BoundNames(d.Id)...)
+			}
+		}
+	case *FunctionDeclaration:
+		if topLevel {
+			names = append(names, s.Id.Name)
+		}
+	case *BlockStatement:
+		for _, statement := range s.Body {
+			names = append(names, VarDeclaredNames(statement, false)...)
+		}
+	case *IfStatement:
+		names = VarDeclaredNames(s.Consequent, false)
+	case *ForStatement:
+		if s.Init != nil {
+			names = VarDeclaredNames(s.Init, false)
+		}
+		names = append(names, VarDeclaredNames(s.Body, false)...)
+	case *ForInStatement:
+		if d, ok := s.Left.(*VariableDeclaration); ok {
+			names = VarDeclaredNames(d, false)
+		}
+		names = append(names, VarDeclaredNames(s.Body, false)...)
+	case *ForOfStatement:
+		if d, ok := s.Left.(*VariableDeclaration); ok {
+			names = VarDeclaredNames(d, false)
+		}
+		names = append(names, VarDeclaredNames(s.Body, false)...)
+	case *WithStatement:
+		names = VarDeclaredNames(s.Body, false)
+	}
+	return names
+}
+
+// https://tc39.es/ecma262/#sec-static-semantics-issimpleparameterlist
+func IsSimpleParameterList(parameters []Pattern) bool {
+	for _, p := range parameters {
+		if _, ok := p.(*Identifier); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsExpression reports whether any of the patterns has a default value
+// or a computed property key, which is evaluated while binding.
+// https://tc39.es/ecma262/#sec-static-semantics-containsexpression
+func ContainsExpression(patterns []Pattern) bool {
+	for _, pattern := range patterns {
+		switch p := pattern.(type) {
+		case *AssignmentPattern:
+			return true
+		case *RestElement:
+			if ContainsExpression([]Pattern{p.Argument}) {
+				return true
+			}
+		case *ArrayPattern:
+			for _, e := range p.Elements {
+				if e != nil && ContainsExpression([]Pattern{e}) {
+					return true
+				}
+			}
+		case *ObjectPattern:
+			for _, property := range p.Properties {
+				switch property := property.(type) {
+				case *Property:
+					if property.Computed || ContainsExpression([]Pattern{property.Value.(Pattern)}) {
+						return true
+					}
+				case *RestElement:
+					if ContainsExpression([]Pattern{property}) {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// ExpectedArgumentCount is the number of parameters before the first one
+// with a default value or the rest parameter.
+// https://tc39.es/ecma262/#sec-static-semantics-expectedargumentcount
+func ExpectedArgumentCount(parameters []Pattern) int {
+	for idx, p := range parameters {
+		switch p.(type) {
+		case *AssignmentPattern, *RestElement:
+			return idx
+		}
+	}
+	return len(parameters)
+}
+
+// Unparenthesized strips the parentheses around an expression.
+func Unparenthesized(expr Expression) Expression {
+	for {
+		p, ok := expr.(*ParenthesizedExpression)
+		if !ok {
+			return expr
+		}
+		expr = p.Expression
+	}
+}
@@ -0,0 +1,374 @@
+package intp
+
+import (
+	"gojs/ast"
+	"gojs/lang"
+)
+
+// https://tc39.es/ecma262/#sec-class-definitions-runtime-semantics-evaluation
+func (i *Interpreter) classDeclaration(n *ast.ClassDeclaration, k cont) step {
+	return i.classDefinition(n.Id.Name, n.Id.Name, n.SuperClass, n.Body, n.SourceText, func(f lang.Value) step {
+		i.initialize(n.Id.Name, f)
+		return k(f)
+	})
+}
+
+// https://tc39.es/ecma262/#sec-class-definitions-runtime-semantics-evaluation
+func (i *Interpreter) classExpression(n *ast.ClassExpression, k cont) step {
+	if n.Id == nil {
+		return i.classDefinition("", "", n.SuperClass, n.Body, n.SourceText, k)
+	}
+	return i.classDefinition(n.Id.Name, n.Id.Name, n.SuperClass, n.Body, n.SourceText, k)
+}
+
+// classDefinition creates the constructor of a class, binding it to the
+// class binding inside the class, which is immutable and cannot be accessed
+// until the class is defined. Static fields and blocks are evaluated in
+// order once the constructor and prototype are complete, while instance
+// fields and private methods are left to the constructor to define.
+// https://tc39.es/ecma262/#sec-runtime-semantics-classdefinitionevaluation
+func (i *Interpreter) classDefinition(binding, name string, superClass ast.Expression, body *ast.ClassBody, sourceText string, k cont) step {
+	i.enterScope()
+	if binding != "" {
+		i.uninitialize([]string{binding})
+	}
+	strict, privateEnv := i.strict, i.frame.privateEnv
+	i.strict = true
+
+	return i.classHeritage(superClass, func(protoParent, constructorParent lang.Object) step {
+		env := lang.NewPrivateEnvironment(i.frame.privateEnv)
+		for _, element := range body.Body {
+			if private := privateBoundName(element); private != "" {
+				env.Names[private] = &lang.PrivateName{Description: "#" + private}
+			}
+		}
+		i.frame.privateEnv = env
+
+		proto := lang.NewJsObject(protoParent)
+
+		var f *lang.Function
+		if constructor := classConstructor(body); constructor != nil {
+			f = i.realm.NewFunction(lang.FunctionKindNormal, name, constructor.Value.Body, constructor.Value.Parameters, sourceText)
+		} else {
+			f = i.realm.NewFunction(lang.FunctionKindNormal, name, nil, nil, sourceText)
+		}
+		f.Strict, f.IsClassConstructor = true, true
+		f.HomeObject, f.Environment, f.PrivateEnvironment = proto, i.closure(), env
+		if superClass != nil {
+			f.ConstructorKind = lang.ConstructorKindDerived
+		}
+
+		// https://tc39.es/ecma262/#sec-makeconstructor
+		f.SetPrototypeOf(constructorParent)
+		f.DefineOwnProperty("prototype", lang.NewDataDescriptor(lang.NewObj(proto), false, false, false))
+		proto.DefineOwnProperty("constructor", lang.NewDataDescriptor(lang.NewObj(f), true, false, true))
+
+		var instancePrivateMethods, staticPrivateMethods []*lang.PrivateElement
+		var instanceFields []lang.ClassFieldDefinition
+		var staticElements []func()
+		return each(len(body.Body), func(idx int, next func() step) step {
+			switch e := body.Body[idx].(type) {
+			case *ast.MethodDefinition:
+				if e.Kind == "constructor" {
+					return next()
+				}
+
+				if !e.Static {
+					return i.methodDefinition(proto, e, func(method *lang.PrivateElement) step {
+						instancePrivateMethods = addPrivateMethod(instancePrivateMethods, method)
+						return next()
+					})
+				}
+				return i.methodDefinition(f, e, func(method *lang.PrivateElement) step {
+					staticPrivateMethods = addPrivateMethod(staticPrivateMethods, method)
+					return next()
+				})
+			case *ast.PropertyDefinition:
+				return i.classFieldDefinition(e, func(field lang.ClassFieldDefinition) step {
+					if !e.Static {
+						instanceFields = append(instanceFields, field)
+					} else {
+						staticElements = append(staticElements, func() {
+							i.defineField(f, field)
+						})
+					}
+					return next()
+				})
+			case *ast.StaticBlock:
+				staticElements = append(staticElements, func() {
+					i.staticBlock(e)
+				})
+			}
+			return next()
+		}, func() step {
+			if binding != "" {
+				i.initialize(binding, lang.NewObj(f))
+				i.latestScope().constants[binding] = true
+			}
+
+			f.PrivateMethods, f.Fields = instancePrivateMethods, instanceFields
+			for _, method := range staticPrivateMethods {
+				lang.PrivateMethodOrAccessorAdd(f, method)
+			}
+
+			i.evaluateStaticElements(f, staticElements)
+			i.exitScope()
+			i.strict, i.frame.privateEnv = strict, privateEnv
+			return k(lang.NewObj(f))
+		})
+	})
+}
+
+// classHeritage evaluates the superclass of a class, continuing with the
+// parents of its prototype and constructor.
+func (i *Interpreter) classHeritage(superClass ast.Expression, k func(protoParent, constructorParent lang.Object) step) step {
+	protoParent, constructorParent := lang.Object(i.realm.ObjectPrototype), lang.Object(i.realm.FunctionPrototype)
+	if superClass == nil {
+		return k(protoParent, constructorParent)
+	}
+
+	return i.eval(superClass, func(superclass lang.Value) step {
+		switch {
+		case superclass.Type == lang.ValueTypeNull:
+			protoParent = nil
+		case !lang.IsConstructor(superclass):
+			lang.ThrowTypeError("Class extends value %s is not a constructor or null", superclass.String())
+		default:
+			prototype := superclass.Obj.Get("prototype", superclass)
+			if prototype.Type == lang.ValueTypeNull {
+				protoParent = nil
+			} else if prototype.Type != lang.ValueTypeObj {
+				lang.ThrowTypeError("Class extends value does not have valid prototype property %s", prototype.String())
+			} else {
+				protoParent = prototype.Obj
+			}
+			constructorParent = superclass.Obj
+		}
+		return k(protoParent, constructorParent)
+	})
+}
+
+// evaluateStaticElements evaluates the static fields and blocks of a class
+// with the constructor as their this value.
+func (i *Interpreter) evaluateStaticElements(f *lang.Function, elements []func()) {
+	defer func(fr frame) {
+		i.frame = fr
+	}(i.frame)
+
+	i.frame = frame{this: lang.NewObj(f), thisBound: true, homeObject: f, privateEnv: f.PrivateEnvironment}
+	for _, element := range elements {
+		element()
+	}
+}
+
+func classConstructor(body *ast.ClassBody) *ast.MethodDefinition {
+	for _, element := range body.Body {
+		if method, ok := element.(*ast.MethodDefinition); ok && method.Kind == "constructor" {
+			return method
+		}
+	}
+	return nil
+}
+
+// https://tc39.es/ecma262/#sec-static-semantics-privateboundidentifiers
+func privateBoundName(element ast.Node) string {
+	var key ast.Expression
+	switch e := element.(type) {
+	case *ast.MethodDefinition:
+		key = e.Key
+	case *ast.PropertyDefinition:
+		key = e.Key
+	}
+
+	if private, ok := key.(*ast.PrivateIdentifier); ok {
+		return private.Name
+	}
+	return ""
+}
+
+// methodDefinition defines a method or accessor on the home object. Private
+// methods are continued with instead, to be added to the instances or the
+// constructor.
+// https://tc39.es/ecma262/#sec-runtime-semantics-methoddefinitionevaluation
+func (i *Interpreter) methodDefinition(home lang.Object, n *ast.MethodDefinition, k func(method *lang.PrivateElement) step) step {
+	if private, ok := n.Key.(*ast.PrivateIdentifier); ok {
+		key := i.frame.privateEnv.Names[private.Name]
+		switch n.Kind {
+		case "get":
+			return k(&lang.PrivateElement{Key: key, Kind: lang.PrivateElementKindAccessor, Get: i.methodValue(n.Value, "get "+key.Description, home)})
+		case "set":
+			return k(&lang.PrivateElement{Key: key, Kind: lang.PrivateElementKindAccessor, Set: i.methodValue(n.Value, "set "+key.Description, home)})
+		default:
+			return k(&lang.PrivateElement{Key: key, Kind: lang.PrivateElementKindMethod, Value: lang.NewObj(i.methodValue(n.Value, key.Description, home))})
+		}
+	}
+
+	return i.propertyKey(n.Key, n.Computed, func(key lang.PropertyKey) step {
+		i.defineMethod(home, n, key)
+		return k(nil)
+	})
+}
+
+// defineMethod defines a method or accessor with a property key on the home
+// object.
+func (i *Interpreter) defineMethod(home lang.Object, n *ast.MethodDefinition, key lang.PropertyKey) {
+	switch n.Kind {
+	case "get":
+		lang.DefinePropertyOrThrow(home, key, lang.PropertyDescriptor{
+			Getter: i.methodValue(n.Value, "get "+key.FunctionName(), home), HasGetter: true,
+			Enumerable: false, HasEnumerable: true,
+			Configurable: true, HasConfigurable: true,
+		})
+	case "set":
+		lang.DefinePropertyOrThrow(home, key, lang.PropertyDescriptor{
+			Setter: i.methodValue(n.Value, "set "+key.FunctionName(), home), HasSetter: true,
+			Enumerable: false, HasEnumerable: true,
+			Configurable: true, HasConfigurable: true,
+		})
+	default:
+		method := lang.NewObj(i.methodValue(n.Value, key.FunctionName(), home))
+		lang.DefinePropertyOrThrow(home, key, lang.NewDataDescriptor(method, true, false, true))
+	}
+}
+
+// addPrivateMethod adds a private method to the methods of a class, combining
+// the getter and setter of a private accessor into a single element.
+func addPrivateMethod(methods []*lang.PrivateElement, method *lang.PrivateElement) []*lang.PrivateElement {
+	if method == nil {
+		return methods
+	}
+
+	for _, existing := range methods {
+		if existing.Key == method.Key {
+			if method.Get != nil {
+				existing.Get = method.Get
+			}
+			if method.Set != nil {
+				existing.Set = method.Set
+			}
+			return methods
+		}
+	}
+	return append(methods, method)
+}
+
+// https://tc39.es/ecma262/#sec-runtime-semantics-classfielddefinitionevaluation
+func (i *Interpreter) classFieldDefinition(n *ast.PropertyDefinition, k func(field lang.ClassFieldDefinition) step) step {
+	if private, ok := n.Key.(*ast.PrivateIdentifier); ok {
+		return k(lang.ClassFieldDefinition{PrivateName: i.frame.privateEnv.Names[private.Name], Initializer: n.Value})
+	}
+	return i.propertyKey(n.Key, n.Computed, func(key lang.PropertyKey) step {
+		return k(lang.ClassFieldDefinition{Name: key, Initializer: n.Value})
+	})
+}
+
+// defineField evaluates the initializer of a field in the running frame and
+// defines the field on the receiver.
+// https://tc39.es/ecma262/#sec-definefield
+func (i *Interpreter) defineField(receiver lang.Object, field lang.ClassFieldDefinition) {
+	name := field.Name.FunctionName()
+	if field.PrivateName != nil {
+		name = field.PrivateName.Description
+	}
+
+	value := lang.NewUndefined()
+	if field.Initializer != nil {
+		value = i.evaluate(func(k cont) step {
+			return i.namedEvaluation(field.Initializer, name, k)
+		})
+	}
+
+	if field.PrivateName != nil {
+		lang.PrivateFieldAdd(receiver, field.PrivateName, value)
+	} else {
+		lang.CreateDataPropertyOrThrow(receiver, field.Name, value)
+	}
+}
+
+// https://tc39.es/ecma262/#sec-runtime-semantics-classstaticblockdefinitionevaluation
+func (i *Interpreter) staticBlock(n *ast.StaticBlock) {
+	i.enterScope()
+	defer i.exitScope()
+
+	i.instantiateDeclarations(n.Body, true)
+	for _, statement := range n.Body {
+		i.Do(statement)
+	}
+}
+
+// initializeInstanceElements adds the private methods and fields of a class
+// to a new instance, evaluating the field initializers with the instance as
+// their this value.
+// https://tc39.es/ecma262/#sec-initializeinstanceelements
+func (i *Interpreter) initializeInstanceElements(o lang.Object, constructor *lang.Function) {
+	for _, method := range constructor.PrivateMethods {
+		lang.PrivateMethodOrAccessorAdd(o, method)
+	}
+
+	if len(constructor.Fields) == 0 {
+		return
+	}
+
+	defer func(strict bool, fr frame) {
+		i.strict, i.frame = strict, fr
+	}(i.strict, i.frame)
+
+	defer i.enterClosure(constructor)()
+
+	i.strict = true
+	i.frame = frame{
+		this:       lang.NewObj(o),
+		thisBound:  true,
+		homeObject: constructor.HomeObject,
+		privateEnv: constructor.PrivateEnvironment,
+	}
+	for _, field := range constructor.Fields {
+		i.defineField(o, field)
+	}
+}
+
+// https://tc39.es/ecma262/#sec-super-keyword-runtime-semantics-evaluation
+func (i *Interpreter) superCall(n *ast.CallExpression, k cont) step {
+	constructor := i.frame.function.GetPrototypeOf()
+	return i.evaluateArguments(n.Arguments, func(args []lang.Value) step {
+		return k(i.constructSuper(constructor, args))
+	})
+}
+
+// constructSuper constructs the this value of a derived constructor with the
+// parent constructor, and initializes the elements of the derived class on
+// it.
+func (i *Interpreter) constructSuper(constructor lang.Object, args []lang.Value) lang.Value {
+	if constructor == nil || !lang.IsConstructor(lang.NewObj(constructor)) {
+		lang.ThrowTypeError("Super constructor is not a constructor")
+	}
+
+	result := lang.Construct(lang.NewObj(constructor), args, i.frame.newTarget)
+	if i.frame.thisBound {
+		lang.ThrowReferenceError("Super constructor may only be called once")
+	}
+
+	i.frame.this, i.frame.thisBound = result, true
+	i.initializeInstanceElements(result.Obj, i.frame.function)
+	return result
+}
+
+// https://tc39.es/ecma262/#sec-makesuperpropertyreference
+func (i *Interpreter) superReference(n *ast.MemberExpression, k func(ref reference) step) step {
+	this := i.resolveThisBinding()
+	return i.propertyKey(n.Property, n.Computed, func(key lang.PropertyKey) step {
+		base := lang.NewNull()
+		if proto := i.frame.homeObject.GetPrototypeOf(); proto != nil {
+			base = lang.NewObj(proto)
+		}
+		return k(reference{base: base, key: key, property: true, super: true, this: this})
+	})
+}
+
+// https://tc39.es/ecma262/#sec-resolvethisbinding
+func (i *Interpreter) resolveThisBinding() lang.Value {
+	if !i.frame.thisBound {
+		lang.ThrowReferenceError("Must call super constructor in derived class before accessing 'this' or returning from derived constructor")
+	}
+	return i.frame.this
+}
@@ -0,0 +1,174 @@
+package intp
+
+import (
+	"gojs/ast"
+	"gojs/lang"
+)
+
+// coroutine evaluates the body of a generator with an interpreter of its
+// own, which holds the scopes and frame of the generator between
+// resumptions. A yield returns the continuation of the body instead of
+// calling it, so a suspended generator is held by its generator object alone,
+// and is collected along with it.
+type coroutine struct {
+	interpreter *Interpreter
+	body        ast.Statement
+	started     bool
+
+	// resume continues the body from the yield it is suspended at.
+	resume func(r resumption) step
+}
+
+// resumption resumes a suspended generator body with a completion.
+type resumption struct {
+	completion lang.CompletionType
+	value      lang.Value
+}
+
+// generatorStart creates the generator returned by a call to a generator
+// function, which keeps the scopes of the call to resume the body with.
+// https://tc39.es/ecma262/#sec-generatorstart
+func (i *Interpreter) generatorStart(f *lang.Function) lang.Value {
+	scopes := i.closure()
+	c := &coroutine{
+		interpreter: &Interpreter{scope: scopes, realm: i.realm, strict: i.strict, frame: i.frame},
+		body:        f.Body,
+	}
+
+	g := i.realm.NewGeneratorObject(f)
+	g.Resume = c.Resume
+	return lang.NewObj(g)
+}
+
+// Resume evaluates the body until it yields or completes. The interpreter of
+// the body evaluates the functions it calls in the meantime.
+// https://tc39.es/ecma262/#sec-generatorresume
+func (c *coroutine) Resume(completion lang.CompletionType, value lang.Value) (lang.Value, bool) {
+	realm := c.interpreter.realm
+	defer func(evaluator lang.Evaluator) {
+		realm.Evaluator = evaluator
+	}(realm.Evaluator)
+	realm.Evaluator = c.interpreter
+
+	s := step{next: c.start}
+	if c.started {
+		resume := c.resume
+		s.next = func() step {
+			return resume(resumption{completion: completion, value: value})
+		}
+	}
+	c.started, c.resume = true, nil
+
+	s = c.run(s)
+	c.resume = s.resume
+	return s.result, s.done
+}
+
+func (c *coroutine) start() step {
+	return c.interpreter.statements(bodyStatements(c.body), func(lang.Value) step {
+		return c.complete(lang.NewUndefined())
+	})
+}
+
+func (c *coroutine) complete(value lang.Value) step {
+	return step{result: lang.NewObj(c.interpreter.realm.CreateIterResultObject(value, true)), done: true}
+}
+
+// run evaluates the body from the step until it yields or completes. A
+// return statement completes the body with its value, and closes the
+// iterators the body is evaluating, including those opened before it was
+// last resumed, like any other abrupt completion.
+func (c *coroutine) run(s step) (result step) {
+	defer func() {
+		if r := recover(); r != nil {
+			r = c.interpreter.closeIterators(0, r)
+			completion, ok := r.(*returnCompletion)
+			if !ok {
+				panic(r)
+			}
+			result = c.complete(completion.value)
+		}
+	}()
+
+	return c.interpreter.run(s)
+}
+
+// yield suspends the body with an iterator result, to be resumed with the
+// continuation.
+// https://tc39.es/ecma262/#sec-generatoryield
+func (i *Interpreter) yield(result lang.Value, resume func(r resumption) step) step {
+	return step{result: result, resume: resume}
+}
+
+// https://tc39.es/ecma262/#sec-yield
+func (i *Interpreter) yieldExpression(n *ast.YieldExpression, k cont) step {
+	if n.Delegate {
+		return i.eval(n.Argument, func(iterable lang.Value) step {
+			return i.yieldDelegate(iterable, k)
+		})
+	}
+
+	argument := func(k cont) step {
+		if n.Argument == nil {
+			return k(lang.NewUndefined())
+		}
+		return i.eval(n.Argument, k)
+	}
+
+	return argument(func(value lang.Value) step {
+		return i.yield(lang.NewObj(i.realm.CreateIterResultObject(value, false)), func(r resumption) step {
+			switch r.completion {
+			case lang.CompletionTypeReturn:
+				panic(&returnCompletion{value: r.value})
+			case lang.CompletionTypeThrow:
+				lang.ThrowValue(r.value)
+			}
+			return k(r.value)
+		})
+	})
+}
+
+// yieldDelegate yields the results of the iterator as they are, forwarding
+// the completions the generator is resumed with to the iterator, until the
+// iterator is done.
+// https://tc39.es/ecma262/#sec-generator-function-definitions-runtime-semantics-evaluation
+func (i *Interpreter) yieldDelegate(iterable lang.Value, k cont) step {
+	ir := i.realm.GetIterator(iterable)
+	iterator := lang.NewObj(ir.Iterator)
+
+	var delegate func(received resumption) step
+	delegate = func(received resumption) step {
+		var result lang.Value
+		switch received.completion {
+		case lang.CompletionTypeNormal:
+			result = lang.Call(ir.NextMethod, iterator, received.value)
+		case lang.CompletionTypeThrow:
+			throw := i.realm.GetMethod(iterator, lang.StrKey("throw"))
+			if throw.Type == lang.ValueTypeUndefined {
+				ir.Close()
+				lang.ThrowTypeError("The iterator does not provide a 'throw' method")
+			}
+			result = lang.Call(throw, iterator, received.value)
+		case lang.CompletionTypeReturn:
+			ret := i.realm.GetMethod(iterator, lang.StrKey("return"))
+			if ret.Type == lang.ValueTypeUndefined {
+				panic(&returnCompletion{value: received.value})
+			}
+			result = lang.Call(ret, iterator, received.value)
+		}
+
+		if result.Type != lang.ValueTypeObj {
+			lang.ThrowTypeError("Iterator result %s is not an object", result.String())
+		}
+
+		if lang.ToBoolean(result.Obj.Get("done", result)) {
+			value := result.Obj.Get("value", result)
+			if received.completion == lang.CompletionTypeReturn {
+				panic(&returnCompletion{value: value})
+			}
+			return k(value)
+		}
+		return i.yield(result, delegate)
+	}
+	return delegate(resumption{completion: lang.CompletionTypeNormal, value: lang.NewUndefined()})
+}
@@ -0,0 +1,117 @@
+package intp
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"gojs/lang"
+	"gojs/parse"
+	"gojs/tkn"
+)
+
+func TestGenerator(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "yield values",
+			src: `function* g() { var x = yield 1; var y = (yield x + 1) * 2; return y }
+				var it = g(); var a = it.next(), b = it.next(5), c = it.next(7), d = it.next()
+				print(a.value, a.done, b.value, b.done, c.value, c.done, d.value, d.done)`,
+			want: "1 false 6 false 14 true undefined true",
+		},
+		{
+			name: "loops",
+			src: `function* g() { for (var i = 0; i < 3; i++) { yield i } for (var k in { a: 1, b: 2 }) { yield k } }
+				var s = ""; for (var v of g()) s += v; print(s)`,
+			want: "012ab",
+		},
+		{
+			name: "long loop",
+			src: `function* g() { var s = 0; for (var i = 0; i < 100000; i++) { s += yield i } return s }
+				var it = g(); it.next(); for (var i = 1; i < 100000; i++) it.next(1); print(it.next(1).value)`,
+			want: "100000",
+		},
+		{
+			name: "delegation",
+			src: `function* inner() { var got = yield 1; return got + "!" }
+				function* g() { yield* [0]; var v = yield* inner(); yield v }
+				var it = g(); it.next(); it.next(); print(it.next("x").value)`,
+			want: "x!",
+		},
+		{
+			name: "expressions",
+			src: `function* g() { var o = { a: yield 1, [yield 2]: [yield 3, ...(yield 4)] }; o.a += yield 5; return o }
+				var it = g(); it.next(); it.next(1); it.next("k"); it.next(2); it.next([3]); var o = it.next(10).value
+				print(o.a, o.k.join())`,
+			want: "11 2,3",
+		},
+		{
+			name: "patterns",
+			src: `function* g() { var [p, q = yield 2] = [yield 1]; var { r = yield 3 } = {}; return p + q + r }
+				var it = g(); it.next(); it.next(1); it.next(2); print(it.next(3).value)`,
+			want: "6",
+		},
+		{
+			name: "templates",
+			src: `function* g() { return ` + "`a${yield 1}b${(yield 2) ? yield 3 : 0}`" + ` }
+				var it = g(); it.next(); it.next("x"); it.next(true); print(it.next("y").value)`,
+			want: "axby",
+		},
+		{
+			name: "class",
+			src: `function* g() { class K extends (yield 1) { [yield 2]() { return "m" } } return new K() }
+				var it = g(); it.next(); it.next(class {}); print(it.next("f").value.f())`,
+			want: "m",
+		},
+		{
+			name: "return closes iterators",
+			src: `var iterable = { [Symbol.iterator]() { return { next() { return { value: 1, done: false } }, return() { print("closed"); return {} } } } }
+				function* g() { for (var x of iterable) { yield x } }
+				var it = g(); it.next(); var r = it.return(3); print(r.value, r.done, it.next().done)`,
+			want: "closed\n3 true true",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := run(t, tt.src); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// A suspended generator reachable from its own scopes must be collected once
+// nothing else refers to it. Go does not run the finalizers of objects in a
+// cycle, so the finalizer is set on an object only the generator refers to.
+func TestGeneratorCycleCollected(t *testing.T) {
+	collected := make(chan bool, 1)
+	i := NewInterpreter()
+	i.put("sentinel", lang.NewObj(i.realm.NewNativeFunction("sentinel", 0, func(this lang.Value, args []lang.Value) lang.Value {
+		o := i.realm.NewObject()
+		runtime.SetFinalizer(o, func(interface{}) {
+			collected <- true
+		})
+		return lang.NewObj(o)
+	})))
+
+	src := `function* g(o) { var s = sentinel(); yield 1; yield s }
+		function f() { var x = {}; x.it = g(x); x.it.next() }
+		f()`
+	program := parse.NewStreamParser(tkn.NewTokenizerBytes([]byte(src))).Parse()
+	i.Do(&program)
+
+	for n := 0; n < 10; n++ {
+		runtime.GC()
+		select {
+		case <-collected:
+			return
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	t.Error("suspended generator was not collected")
+}
@@ -1,57 +1,274 @@
 package intp
 
 import (
+	"math/big"
+	"strconv"
+	"strings"
+
 	"gojs/ast"
 	"gojs/lang"
 )
 
+// scope holds variable bindings. The scope of a with statement binds the
+// properties of its object instead.
 type scope struct {
-	vars map[string]lang.Value
+	vars   map[string]lang.Value
+	object lang.Object
+
+	// constants are the names of the immutable bindings of vars, which const
+	// declarations create.
+	constants map[string]bool
+
+	// uninitialized are the names of the bindings of vars that cannot be
+	// accessed yet, because their let, const or class declarations have not
+	// been evaluated.
+	uninitialized map[string]bool
 }
 
 func newScope() scope {
-	return scope{make(map[string]lang.Value)}
+	return scope{vars: make(map[string]lang.Value), constants: make(map[string]bool), uninitialized: make(map[string]bool)}
+}
+
+// https://tc39.es/ecma262/#sec-object-environment-records
+func newObjectScope(o lang.Object) scope {
+	return scope{object: o}
 }
 
 func (s scope) Put(name string, value lang.Value) {
+	if s.object != nil {
+		s.object.Set(name, value, lang.NewObj(s.object))
+		return
+	}
 	s.vars[name] = value
 }
 
 func (s scope) Get(name string) (lang.Value, bool) {
+	if s.object != nil {
+		if !s.hasBinding(name) {
+			return lang.Value{}, false
+		}
+		return s.object.Get(name, lang.NewObj(s.object)), true
+	}
+
 	v, ok := s.vars[name]
 	return v, ok
 }
 
+// Properties listed by the @@unscopables object of the object are not bound.
+// https://tc39.es/ecma262/#sec-object-environment-records-hasbinding-n
+func (s scope) hasBinding(name string) bool {
+	if !s.object.HasProperty(name) {
+		return false
+	}
+
+	unscopables := lang.SymbolKey(lang.SymbolUnscopables).Get(s.object, lang.NewObj(s.object))
+	if unscopables.Type == lang.ValueTypeObj {
+		return !lang.ToBoolean(unscopables.Obj.Get(name, unscopables))
+	}
+	return true
+}
+
+// frame holds the state of the running function call: its this binding,
+// which is unbound in a derived constructor until super is called, and what
+// super and private names refer to.
+type frame struct {
+	function   *lang.Function
+	this       lang.Value
+	thisBound  bool
+	newTarget  lang.Object
+	homeObject lang.Object
+	privateEnv *lang.PrivateEnvironment
+}
+
 type Interpreter struct {
 	scope []scope
+	realm *lang.Realm
+
+	// strict is set while evaluating strict mode code.
+	strict bool
+	frame  frame
+
+	// iterators are the iterators of the for-of statements and array
+	// patterns being evaluated, which are closed when their evaluation
+	// completes abruptly.
+	iterators []*lang.IteratorRecord
 }
 
-func NewInterpreter() *Interpreter {
-	return &Interpreter{scope: []scope{newScope()}}
+type Option func(i *Interpreter)
+
+// WithStrict evaluates programs as strict mode code, whether or not they
+// begin with a "use strict" directive.
+func WithStrict() Option {
+	return func(i *Interpreter) {
+		i.strict = true
+	}
+}
+
+func NewInterpreter(options ...Option) *Interpreter {
+	i := &Interpreter{scope: []scope{newScope()}, realm: lang.NewRealm()}
+	i.realm.Evaluator = i
+	i.frame = frame{this: lang.NewObj(i.realm.GlobalObject), thisBound: true}
+	for _, option := range options {
+		option(i)
+	}
+	return i
 }
 
 func (i *Interpreter) BindNativeFunction(name string, f func(values ...lang.Value)) {
-	binder := lang.NewObj(&lang.NativeFunction{Function: f})
+	binder := lang.NewObj(i.realm.NewNativeFunction(name, 0, func(this lang.Value, args []lang.Value) lang.Value {
+		f(args...)
+		return lang.NewUndefined()
+	}))
+	i.put(name, binder)
+}
+
+// BindValue binds the name to a Go value converted with lang.ToValue.
+func (i *Interpreter) BindValue(name string, v interface{}) {
+	i.put(name, lang.ToValue(v))
+}
+
+// BindFunction binds the name to a function returning a Go value, which is
+// converted with lang.ToValue.
+func (i *Interpreter) BindFunction(name string, f func(values ...lang.Value) interface{}) {
+	binder := lang.NewObj(i.realm.NewNativeFunction(name, 0, func(this lang.Value, args []lang.Value) lang.Value {
+		return lang.ToValue(f(args...))
+	}))
 	i.put(name, binder)
 }
 
 func (i *Interpreter) get(name string) lang.Value {
+	if v, ok := i.lookup(name); ok {
+		return v
+	}
+	panic("var: " + name + " not in scope")
+}
+
+func (i *Interpreter) lookup(name string) (lang.Value, bool) {
 	for idx := len(i.scope) - 1; idx >= 0; idx-- {
 		s := i.scope[idx]
 		if v, ok := s.Get(name); ok {
-			return v
+			s.checkInitialized(name)
+			return v, true
 		}
 	}
-	panic("var: " + name + " not in scope")
-	return lang.Value{}
+
+	global := i.realm.GlobalObject
+	if global.HasProperty(name) {
+		return global.Get(name, lang.NewObj(global)), true
+	}
+	return lang.Value{}, false
+}
+
+// set assigns to the nearest binding of the name, creating a global binding
+// when it is not declared.
+func (i *Interpreter) set(name string, value lang.Value) {
+	for idx := len(i.scope) - 1; idx >= 0; idx-- {
+		if _, ok := i.scope[idx].Get(name); ok {
+			i.scope[idx].checkInitialized(name)
+			if i.scope[idx].constants[name] {
+				lang.ThrowTypeError("Assignment to constant variable '%s'", name)
+			}
+			i.scope[idx].Put(name, value)
+			return
+		}
+	}
+
+	global := i.realm.GlobalObject
+	if global.HasProperty(name) {
+		if !global.Set(name, value, lang.NewObj(global)) && i.strict {
+			lang.ThrowTypeError("Cannot assign to read only property '%s' of object", name)
+		}
+		return
+	}
+
+	// https://tc39.es/ecma262/#sec-putvalue
+	if i.strict {
+		lang.ThrowReferenceError("%s is not defined", name)
+	}
+	i.scope[0].Put(name, value)
 }
 
 func (i *Interpreter) put(name string, value lang.Value) {
 	i.latestScope().Put(name, value)
 }
 
+// uninitialize creates bindings in the current scope that cannot be accessed
+// until they are initialized.
+func (i *Interpreter) uninitialize(names []string) {
+	s := i.latestScope()
+	for _, name := range names {
+		s.vars[name] = lang.NewUndefined()
+		s.uninitialized[name] = true
+	}
+}
+
+// initialize binds the name in the current scope, ending the temporal dead
+// zone of its binding.
+func (i *Interpreter) initialize(name string, value lang.Value) {
+	s := i.latestScope()
+	s.vars[name] = value
+	delete(s.uninitialized, name)
+}
+
+// checkInitialized throws a ReferenceError for a binding accessed in its
+// temporal dead zone.
+// https://tc39.es/ecma262/#sec-declarative-environment-records-getbindingvalue-n-s
+func (s scope) checkInitialized(name string) {
+	if len(s.uninitialized) > 0 && s.uninitialized[name] {
+		lang.ThrowReferenceError("Cannot access '%s' before initialization", name)
+	}
+}
+
+// instantiateDeclarations creates the bindings of the declarations of a
+// statement list in the current scope. The bindings of let, const and class
+// declarations are uninitialized until the declarations are evaluated, while
+// functions are created up front. At the top level of a function or script,
+// the bindings of var declarations are initialized to undefined unless they
+// are already bound, such as to parameters.
+// https://tc39.es/ecma262/#sec-blockdeclarationinstantiation
+// https://tc39.es/ecma262/#sec-functiondeclarationinstantiation
+// https://tc39.es/ecma262/#sec-globaldeclarationinstantiation
+func (i *Interpreter) instantiateDeclarations(statements []ast.Statement, topLevel bool) {
+	s := i.latestScope()
+	for _, statement := range statements {
+		if topLevel {
+			for _, name := range ast.VarDeclaredNames(statement, true) {
+				if _, ok := s.vars[name]; !ok {
+					s.vars[name] = lang.NewUndefined()
+				}
+			}
+		}
+		if _, ok := statement.(*ast.FunctionDeclaration); !ok {
+			i.uninitialize(ast.LexicallyDeclaredNames(statement, topLevel))
+		}
+	}
+
+	for _, statement := range statements {
+		if n, ok := statement.(*ast.FunctionDeclaration); ok {
+			i.initialize(n.Id.Name, lang.NewObj(i.instantiateFunctionObject(n)))
+		}
+	}
+}
+
+// hasLexicalDeclarations reports whether a block declares any names of its
+// own, and so needs a scope.
+func hasLexicalDeclarations(statements []ast.Statement) bool {
+	for _, statement := range statements {
+		if len(ast.LexicallyDeclaredNames(statement, false)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// latestScope returns the innermost scope holding variable bindings, which
+// receives new bindings.
 func (i *Interpreter) latestScope() scope {
-	return i.scope[len(i.scope)-1]
+	idx := len(i.scope) - 1
+	for i.scope[idx].object != nil {
+		idx--
+	}
+	return i.scope[idx]
 }
 
 func (i *Interpreter) enterScope() {
@@ -62,261 +279,1397 @@ func (i *Interpreter) exitScope() {
 	i.scope = i.scope[:len(i.scope)-1]
 }
 
+// step is how far an evaluation got: to its end, or to a yield, with the
+// iterator result it yields and the continuation that resumes it. Lists and
+// loops return a step with next instead of evaluating their next element, so
+// that they do not grow the stack.
+type step struct {
+	next   func() step
+	result lang.Value
+	resume func(r resumption) step
+	done   bool
+}
+
+// cont continues an evaluation with the value of a part of it.
+type cont func(v lang.Value) step
+
+// Do evaluates a node to completion. Nodes are evaluated in
+// continuation-passing style, so that the evaluation of a generator body can
+// be suspended at a yield, which returns the continuation of the body instead
+// of calling it.
 func (i *Interpreter) Do(n ast.Node) lang.Value {
+	return i.evaluate(func(k cont) step {
+		return i.eval(n, k)
+	})
+}
+
+// evaluate runs an evaluation to completion, returning the value it
+// continues with.
+func (i *Interpreter) evaluate(f func(k cont) step) lang.Value {
+	var value lang.Value
+	i.run(step{next: func() step {
+		return f(func(v lang.Value) step {
+			value = v
+			return step{}
+		})
+	}})
+	return value
+}
+
+// run evaluates from the step until the evaluation completes or yields. When
+// it completes abruptly, the iterators it opened are closed and the scopes it
+// entered are exited.
+func (i *Interpreter) run(s step) step {
+	scopes, iterators := len(i.scope), len(i.iterators)
+	strict, privateEnv := i.strict, i.frame.privateEnv
+	defer func() {
+		if r := recover(); r != nil {
+			r = i.closeIterators(iterators, r)
+			i.scope, i.strict, i.frame.privateEnv = i.scope[:scopes], strict, privateEnv
+			panic(r)
+		}
+	}()
+
+	for s.next != nil {
+		s = s.next()
+	}
+	return s
+}
+
+// closeIterators closes the iterators opened after the first depth ones on
+// the abrupt completion r, innermost first, returning the completion to
+// continue with.
+func (i *Interpreter) closeIterators(depth int, r interface{}) interface{} {
+	for idx := len(i.iterators) - 1; idx >= depth; idx-- {
+		r = closeIterator(i.iterators[idx], r)
+	}
+	i.iterators = i.iterators[:depth]
+	return r
+}
+
+// closeIterator closes an iterator that is not done on the abrupt completion
+// r, returning the completion to continue with: a return closes the iterator
+// normally, so an exception thrown while closing replaces it.
+// https://tc39.es/ecma262/#sec-iteratorclose
+func closeIterator(ir *lang.IteratorRecord, r interface{}) (result interface{}) {
+	if ir.Done {
+		return r
+	}
+
+	if _, ok := r.(*returnCompletion); !ok {
+		ir.CloseAfterThrow()
+		return r
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			result = e
+		}
+	}()
+	ir.Close()
+	return r
+}
+
+// each evaluates f for the indices up to n in turn, and then k.
+func each(n int, f func(idx int, next func() step) step, k func() step) step {
+	var loop func(idx int) step
+	loop = func(idx int) step {
+		if idx == n {
+			return k()
+		}
+		return f(idx, func() step {
+			return step{next: func() step {
+				return loop(idx + 1)
+			}}
+		})
+	}
+	return loop(0)
+}
+
+// eval evaluates a node, and continues with its value.
+func (i *Interpreter) eval(n ast.Node, k cont) step {
 	switch n := n.(type) {
 	case *ast.ArrayExpression:
-		return i.arrayExpression(n)
+		return i.arrayExpression(n, k)
 	case *ast.AssignmentExpression:
-		return i.assignmentExpression(n)
+		return i.assignmentExpression(n, k)
+	case *ast.BigIntLiteral:
+		return k(lang.NewBigInt(n.Value))
 	case *ast.BinaryExpression:
-		return i.binaryExpression(n)
+		return i.binaryExpression(n, k)
+	case *ast.BooleanLiteral:
+		return k(lang.NewBool(n.Value))
 	case *ast.BlockStatement:
-		return i.blockStatement(n)
+		return i.blockStatement(n, k)
 	case *ast.CallExpression:
-		return i.callExpression(n)
+		return i.callExpression(n, k)
+	case *ast.ChainExpression:
+		return i.chainExpression(n, k)
+	case *ast.ClassDeclaration:
+		return i.classDeclaration(n, k)
+	case *ast.ClassExpression:
+		return i.classExpression(n, k)
+	case *ast.ConditionalExpression:
+		return i.conditionalExpression(n, k)
 	case *ast.ExpressionStatement:
-		return i.expressionStatement(n)
+		return i.eval(n.Expression, k)
 	case *ast.ForStatement:
-		return i.forStatement(n)
+		return i.forStatement(n, k)
+	case *ast.ForInStatement:
+		return i.forInStatement(n, k)
+	case *ast.ForOfStatement:
+		return i.forOfStatement(n, k)
 	case *ast.FunctionDeclaration:
-		return i.functionDeclaration(n)
+		return k(lang.Value{})
+	case *ast.FunctionExpression:
+		return k(i.functionExpression(n))
 	case *ast.Identifier:
-		return i.identifier(n)
+		return k(i.identifier(n))
 	case *ast.IfStatement:
-		return i.ifStatement(n)
-	case *ast.IntLiteral:
-		return i.intLiteral(n)
+		return i.ifStatement(n, k)
+	case *ast.LogicalExpression:
+		return i.logicalExpression(n, k)
 	case *ast.MemberExpression:
-		return i.memberExpression(n)
+		return i.memberExpression(n, k)
+	case *ast.NewExpression:
+		return i.newExpression(n, k)
+	case *ast.NullLiteral:
+		return k(lang.NewNull())
+	case *ast.NumericLiteral:
+		return k(i.numericLiteral(n))
 	case *ast.ObjectExpression:
-		return i.objectExpression(n)
+		return i.objectExpression(n, k)
+	case *ast.ParenthesizedExpression:
+		return i.eval(n.Expression, k)
 	case *ast.Program:
-		return i.program(n)
+		return i.program(n, k)
+	case *ast.RegExpLiteral:
+		return k(i.regExpLiteral(n))
 	case *ast.ReturnStatement:
 		return i.returnStatement(n)
+	case *ast.SequenceExpression:
+		return i.sequenceExpression(n, k)
+	case *ast.StringLiteral:
+		return k(i.stringLiteral(n))
+	case *ast.TaggedTemplateExpression:
+		return i.taggedTemplateExpression(n, k)
+	case *ast.TemplateLiteral:
+		return i.templateLiteral(n, k)
+	case *ast.ThisExpression:
+		return k(i.resolveThisBinding())
+	case *ast.UnaryExpression:
+		return i.unaryExpression(n, k)
 	case *ast.UpdateExpression:
-		return i.updateExpression(n)
-	case *ast.VariableDeclarator:
-		return i.variableDeclarator(n)
+		return i.updateExpression(n, k)
 	case *ast.VariableDeclaration:
-		return i.variableDeclaration(n)
+		return i.variableDeclaration(n, k)
+	case *ast.WithStatement:
+		return i.withStatement(n, k)
+	case *ast.YieldExpression:
+		return i.yieldExpression(n, k)
 	default:
 		panic("unsupported node")
 	}
-	return lang.Value{}
 }
 
-func (i *Interpreter) blockStatement(n *ast.BlockStatement) lang.Value {
-	lv := lang.Value{}
-	for _, n1 := range n.Body {
-		lv = i.Do(n1)
+// statements evaluates a statement list, continuing with the value of the
+// last statement.
+func (i *Interpreter) statements(body []ast.Statement, k cont) step {
+	value := lang.Value{}
+	return each(len(body), func(idx int, next func() step) step {
+		return i.eval(body[idx], func(v lang.Value) step {
+			value = v
+			return next()
+		})
+	}, func() step {
+		return k(value)
+	})
+}
+
+// expressions evaluates expressions in order, continuing with their values.
+func (i *Interpreter) expressions(exprs []ast.Expression, k func(values []lang.Value) step) step {
+	values := make([]lang.Value, 0, len(exprs))
+	return each(len(exprs), func(idx int, next func() step) step {
+		return i.eval(exprs[idx], func(v lang.Value) step {
+			values = append(values, v)
+			return next()
+		})
+	}, func() step {
+		return k(values)
+	})
+}
+
+// https://tc39.es/ecma262/#sec-block-runtime-semantics-evaluation
+func (i *Interpreter) blockStatement(n *ast.BlockStatement, k cont) step {
+	if !hasLexicalDeclarations(n.Body) {
+		return i.statements(n.Body, k)
 	}
-	return lv
+
+	i.enterScope()
+	i.instantiateDeclarations(n.Body, false)
+	return i.statements(n.Body, func(v lang.Value) step {
+		i.exitScope()
+		return k(v)
+	})
 }
 
-func (i *Interpreter) program(n *ast.Program) lang.Value {
-	lv := lang.Value{}
-	for _, n1 := range n.Body {
-		lv = i.Do(n1)
+// https://tc39.es/ecma262/#sec-runtime-semantics-scriptevaluation
+func (i *Interpreter) program(n *ast.Program, k cont) step {
+	strict := i.strict
+	i.strict = i.strict || n.Strict
+
+	statements := make([]ast.Statement, 0, len(n.Body))
+	for _, node := range n.Body {
+		statements = append(statements, node.(ast.Statement))
 	}
-	return lv
+
+	i.instantiateDeclarations(statements, true)
+	return i.statements(statements, func(v lang.Value) step {
+		i.strict = strict
+		return k(v)
+	})
 }
 
 func (i *Interpreter) identifier(n *ast.Identifier) lang.Value {
 	return i.get(n.Name)
 }
 
-func (i *Interpreter) ifStatement(n *ast.IfStatement) lang.Value {
-	test := i.Do(n.Test)
+func (i *Interpreter) ifStatement(n *ast.IfStatement, k cont) step {
+	return i.eval(n.Test, func(test lang.Value) step {
+		if !lang.ToBoolean(test) {
+			return k(lang.NewUndefined())
+		}
 
-	v := lang.NewUndefined()
-	if test.Bool {
-		i.enterScope()
-		v = i.Do(n.Consequent)
-		i.exitScope()
+		return i.eval(n.Consequent, k)
+	})
+}
+
+// https://tc39.es/ecma262/#sec-variable-statement-runtime-semantics-evaluation
+func (i *Interpreter) variableDeclarator(n *ast.VariableDeclarator, kind string, k cont) step {
+	// The bindings of var declarations are created when their scope is
+	// entered, and assigned like any other variable, while the bindings of
+	// let and const declarations are initialized here.
+	declare := kind != "var"
+	if n.Init == nil {
+		if declare {
+			i.initialize(n.Id.(*ast.Identifier).Name, lang.NewUndefined())
+		}
+		return k(lang.NewUndefined())
 	}
-	return v
+
+	return i.evaluateInitializer(n.Init, n.Id, func(value lang.Value) step {
+		return i.bindPattern(n.Id, value, declare, func(lang.Value) step {
+			i.declareConstants(n.Id, kind)
+			return k(value)
+		})
+	})
 }
 
-func (i *Interpreter) variableDeclarator(n *ast.VariableDeclarator) lang.Value {
-	init := i.Do(n.Init)
-	i.put(n.Id.Name, init)
-	return init
+// declareConstants makes the bindings of a const declaration immutable.
+func (i *Interpreter) declareConstants(target ast.Pattern, kind string) {
+	if kind != "const" {
+		return
+	}
+
+	constants := i.latestScope().constants
+	for _, name := range ast.BoundNames(target) {
+		constants[name] = true
+	}
 }
 
-func (i *Interpreter) arrayExpression(n *ast.ArrayExpression) lang.Value {
-	results := make([]lang.Value, len(n.Elements))
-	for ix, e := range n.Elements {
-		results[ix] = i.Do(e)
+// Elisions leave holes in the array, which still count towards its length.
+// https://tc39.es/ecma262/#sec-runtime-semantics-arrayaccumulation
+func (i *Interpreter) arrayExpression(n *ast.ArrayExpression, k cont) step {
+	a := i.realm.NewArray(nil)
+
+	nextIndex := 0
+	return each(len(n.Elements), func(idx int, next func() step) step {
+		switch e := n.Elements[idx].(type) {
+		case nil:
+			nextIndex++
+			return next()
+		case *ast.SpreadElement:
+			return i.eval(e.Argument, func(iterable lang.Value) step {
+				ir := i.realm.GetIterator(iterable)
+				for value, ok := ir.StepValue(); ok; value, ok = ir.StepValue() {
+					lang.CreateDataPropertyOrThrow(a, lang.StrKey(strconv.Itoa(nextIndex)), value)
+					nextIndex++
+				}
+				return next()
+			})
+		default:
+			return i.eval(e, func(value lang.Value) step {
+				lang.CreateDataPropertyOrThrow(a, lang.StrKey(strconv.Itoa(nextIndex)), value)
+				nextIndex++
+				return next()
+			})
+		}
+	}, func() step {
+		lang.Set(a, lang.StrKey("length"), lang.NewInt(nextIndex), true)
+		return k(lang.NewObj(a))
+	})
+}
+
+// https://tc39.es/ecma262/#sec-object-initializer-runtime-semantics-evaluation
+func (i *Interpreter) objectExpression(n *ast.ObjectExpression, k cont) step {
+	o := i.realm.NewObject()
+	return each(len(n.Properties), func(idx int, next func() step) step {
+		switch p := n.Properties[idx].(type) {
+		case *ast.SpreadElement:
+			return i.eval(p.Argument, func(v lang.Value) step {
+				i.realm.CopyDataProperties(o, v, nil)
+				return next()
+			})
+		case *ast.Property:
+			return i.propertyDefinition(o, p, next)
+		}
+		return next()
+	}, func() step {
+		return k(lang.NewObj(o))
+	})
+}
+
+// https://tc39.es/ecma262/#sec-runtime-semantics-propertydefinitionevaluation
+func (i *Interpreter) propertyDefinition(o lang.Object, p *ast.Property, k func() step) step {
+	if p.IsProtoSetter() {
+		return i.eval(p.Value, func(v lang.Value) step {
+			setPrototypeFromLiteral(o, v)
+			return k()
+		})
 	}
 
-	return lang.NewObj(&lang.Array{Store: results})
+	return i.propertyKey(p.Key, p.Computed, func(key lang.PropertyKey) step {
+		if p.Kind != "init" || p.Method {
+			i.defineMethodProperty(o, p, key)
+			return k()
+		}
+		return i.namedEvaluation(p.Value, key.FunctionName(), func(v lang.Value) step {
+			lang.CreateDataPropertyOrThrow(o, key, v)
+			return k()
+		})
+	})
 }
 
-func (i *Interpreter) objectExpression(n *ast.ObjectExpression) lang.Value {
-	properties := make(map[string]lang.Value)
-	for _, p := range n.Properties {
-		key := p.Key.(*ast.Identifier)
-		value := i.Do(p.Value)
-		properties[key.Name] = value
+// setPrototypeFromLiteral sets the prototype of an object literal from a
+// __proto__ property, which ignores values other than objects and null.
+func setPrototypeFromLiteral(o lang.Object, value lang.Value) {
+	if value.Type == lang.ValueTypeObj {
+		o.SetPrototypeOf(value.Obj)
+	} else if value.Type == lang.ValueTypeNull {
+		o.SetPrototypeOf(nil)
 	}
+}
 
-	return lang.NewObj(&lang.JsObject{Storage: properties})
+// defineMethodProperty defines a method or accessor of an object literal.
+func (i *Interpreter) defineMethodProperty(o lang.Object, p *ast.Property, key lang.PropertyKey) {
+	switch p.Kind {
+	case "get":
+		getter := i.methodValue(p.Value.(*ast.FunctionExpression), "get "+key.FunctionName(), o)
+		lang.DefinePropertyOrThrow(o, key, lang.PropertyDescriptor{
+			Getter: getter, HasGetter: true,
+			Enumerable: true, HasEnumerable: true,
+			Configurable: true, HasConfigurable: true,
+		})
+	case "set":
+		setter := i.methodValue(p.Value.(*ast.FunctionExpression), "set "+key.FunctionName(), o)
+		lang.DefinePropertyOrThrow(o, key, lang.PropertyDescriptor{
+			Setter: setter, HasSetter: true,
+			Enumerable: true, HasEnumerable: true,
+			Configurable: true, HasConfigurable: true,
+		})
+	default:
+		lang.CreateDataPropertyOrThrow(o, key, lang.NewObj(i.methodValue(p.Value.(*ast.FunctionExpression), key.FunctionName(), o)))
+	}
 }
 
-func (i *Interpreter) assignmentExpression(n *ast.AssignmentExpression) lang.Value {
-	update := i.Do(n.Right)
-	if identifier, ok := n.Left.(*ast.Identifier); ok {
-		i.put(identifier.Name, update)
-	} else if member, ok := n.Left.(*ast.MemberExpression); ok {
-		o, property, _ := i.resolveMemberExpression(member)
-		o.SetProperty(property, update)
-	} else {
-		panic("unsupported assignment expression")
+// namedEvaluation evaluates an expression, naming an anonymous function
+// after the binding or property it is assigned to.
+// https://tc39.es/ecma262/#sec-runtime-semantics-namedevaluation
+func (i *Interpreter) namedEvaluation(expr ast.Expression, name string, k cont) step {
+	switch f := ast.Unparenthesized(expr).(type) {
+	case *ast.FunctionExpression:
+		if f.Id == nil {
+			return k(lang.NewObj(i.functionValue(f, lang.FunctionKindNormal, name)))
+		}
+	case *ast.ClassExpression:
+		if f.Id == nil {
+			return i.classDefinition("", name, f.SuperClass, f.Body, f.SourceText, k)
+		}
 	}
+	return i.eval(expr, k)
+}
 
-	return lang.NewUndefined()
+// propertyKey evaluates a property name, which is either computed or a
+// literal identifier, string or number.
+// https://tc39.es/ecma262/#sec-object-initializer-runtime-semantics-evaluation
+func (i *Interpreter) propertyKey(key ast.Expression, computed bool, k func(key lang.PropertyKey) step) step {
+	if identifier, ok := key.(*ast.Identifier); ok && !computed {
+		return k(lang.StrKey(identifier.Name))
+	}
+	return i.eval(key, func(v lang.Value) step {
+		return k(lang.ToPropertyKey(v))
+	})
 }
 
-func (i *Interpreter) binaryExpression(n *ast.BinaryExpression) lang.Value {
-	l := i.Do(n.Left)
-	r := i.Do(n.Right)
-	if n.Operator == "+" {
-		return lang.NewInt(l.Int + r.Int)
-	} else if n.Operator == ">" {
-		return lang.NewBool(l.Int > r.Int)
-	} else if n.Operator == "<" {
-		return lang.NewBool(l.Int < r.Int)
-	} else {
-		panic("unsupported operation")
+// https://tc39.es/ecma262/#sec-assignment-operators-runtime-semantics-evaluation
+func (i *Interpreter) assignmentExpression(n *ast.AssignmentExpression, k cont) step {
+	switch left := n.Left.(type) {
+	case *ast.ObjectPattern, *ast.ArrayPattern:
+		return i.eval(n.Right, func(value lang.Value) step {
+			return i.bindPattern(left.(ast.Pattern), value, false, func(lang.Value) step {
+				return k(value)
+			})
+		})
 	}
+
+	return i.resolveReference(n.Left, func(ref reference) step {
+		put := func(update lang.Value) step {
+			i.putValue(ref, update)
+			return k(update)
+		}
+
+		// An anonymous function assigned to an identifier is named after it,
+		// unless the identifier is parenthesized.
+		right := func() step {
+			if id, ok := n.Left.(*ast.Identifier); ok {
+				return i.namedEvaluation(n.Right, id.Name, put)
+			}
+			return i.eval(n.Right, put)
+		}
+
+		switch n.Operator {
+		case "=":
+			return right()
+		case "&&=", "||=", "??=":
+			current := i.getValue(ref)
+			if (n.Operator == "&&=" && !lang.ToBoolean(current)) ||
+				(n.Operator == "||=" && lang.ToBoolean(current)) ||
+				(n.Operator == "??=" && !isNullish(current)) {
+				return k(current)
+			}
+			return right()
+		default:
+			current := i.getValue(ref)
+			operator := strings.TrimSuffix(n.Operator, "=")
+			return i.eval(n.Right, func(r lang.Value) step {
+				return put(lang.ApplyStringOrNumericBinaryOperator(current, operator, r))
+			})
+		}
+	})
 }
 
-func (i *Interpreter) identifierUpdateExpression(n *ast.UpdateExpression, identifier *ast.Identifier) lang.Value {
-	arg := i.Do(n.Argument)
-	if n.Operator == "++" {
-		update := lang.NewInt(arg.Int + 1)
-		i.put(identifier.Name, update)
-		return update
-	} else if n.Operator == "--" {
-		update := lang.NewInt(arg.Int - 1)
-		i.put(identifier.Name, update)
-		return update
-	} else {
+func (i *Interpreter) binaryExpression(n *ast.BinaryExpression, k cont) step {
+	// https://tc39.es/ecma262/#sec-relational-operators-runtime-semantics-evaluation
+	if private, ok := n.Left.(*ast.PrivateIdentifier); ok {
+		return i.eval(n.Right, func(r lang.Value) step {
+			return k(i.privateIn(private, r))
+		})
+	}
+
+	return i.eval(n.Left, func(l lang.Value) step {
+		return i.eval(n.Right, func(r lang.Value) step {
+			return k(binaryOperation(l, n.Operator, r))
+		})
+	})
+}
+
+func (i *Interpreter) privateIn(private *ast.PrivateIdentifier, r lang.Value) lang.Value {
+	if r.Type != lang.ValueTypeObj {
+		lang.ThrowTypeError("Cannot use 'in' operator to search for '#%s' in %s", private.Name, r.String())
+	}
+	return lang.NewBool(lang.PrivateElementFind(r.Obj, i.frame.privateEnv.Resolve(private.Name)) != nil)
+}
+
+func binaryOperation(l lang.Value, operator string, r lang.Value) lang.Value {
+	switch operator {
+	case "==":
+		return lang.NewBool(lang.IsLooselyEqual(l, r))
+	case "!=":
+		return lang.NewBool(!lang.IsLooselyEqual(l, r))
+	case "===":
+		return lang.NewBool(lang.IsStrictlyEqual(l, r))
+	case "!==":
+		return lang.NewBool(!lang.IsStrictlyEqual(l, r))
+	case "<":
+		result, undefined := lang.IsLessThan(l, r, true)
+		return lang.NewBool(result && !undefined)
+	case ">":
+		result, undefined := lang.IsLessThan(r, l, false)
+		return lang.NewBool(result && !undefined)
+	case "<=":
+		result, undefined := lang.IsLessThan(r, l, false)
+		return lang.NewBool(!result && !undefined)
+	case ">=":
+		result, undefined := lang.IsLessThan(l, r, true)
+		return lang.NewBool(!result && !undefined)
+	case "instanceof":
+		return lang.NewBool(lang.InstanceofOperator(l, r))
+	case "in":
+		if r.Type != lang.ValueTypeObj {
+			lang.ThrowTypeError("Cannot use 'in' operator to search for '%s' in %s", lang.ToString(l), r.String())
+		}
+		return lang.NewBool(lang.ToPropertyKey(l).HasProperty(r.Obj))
+	default:
+		return lang.ApplyStringOrNumericBinaryOperator(l, operator, r)
+	}
+}
+
+// https://tc39.es/ecma262/#sec-binary-logical-operators-runtime-semantics-evaluation
+func (i *Interpreter) logicalExpression(n *ast.LogicalExpression, k cont) step {
+	return i.eval(n.Left, func(l lang.Value) step {
+		switch n.Operator {
+		case "&&":
+			if !lang.ToBoolean(l) {
+				return k(l)
+			}
+		case "||":
+			if lang.ToBoolean(l) {
+				return k(l)
+			}
+		case "??":
+			if !isNullish(l) {
+				return k(l)
+			}
+		default:
+			panic("unsupported operation")
+		}
+
+		return i.eval(n.Right, k)
+	})
+}
+
+// https://tc39.es/ecma262/#sec-unary-operators
+func (i *Interpreter) unaryExpression(n *ast.UnaryExpression, k cont) step {
+	switch n.Operator {
+	case "delete":
+		return i.deleteExpression(n, k)
+	case "typeof":
+		// https://tc39.es/ecma262/#sec-typeof-operator-runtime-semantics-evaluation
+		if identifier, ok := ast.Unparenthesized(n.Argument).(*ast.Identifier); ok {
+			if v, ok := i.lookup(identifier.Name); ok {
+				return k(lang.NewStr(lang.TypeOf(v)))
+			}
+			return k(lang.NewStr("undefined"))
+		}
+		return i.eval(n.Argument, func(v lang.Value) step {
+			return k(lang.NewStr(lang.TypeOf(v)))
+		})
+	}
+
+	return i.eval(n.Argument, func(v lang.Value) step {
+		return k(unaryOperation(n.Operator, v))
+	})
+}
+
+func unaryOperation(operator string, v lang.Value) lang.Value {
+	switch operator {
+	case "void":
+		return lang.NewUndefined()
+	case "+":
+		return lang.NewNumber(lang.ToNumber(v))
+	case "-":
+		return lang.UnaryMinus(v)
+	case "~":
+		return lang.BitwiseNot(v)
+	case "!":
+		return lang.NewBool(!lang.ToBoolean(v))
+	default:
 		panic("unsupported operation")
 	}
 }
 
-func (i *Interpreter) memberUpdateExpression(n *ast.UpdateExpression, me *ast.MemberExpression) lang.Value {
-	o, property, currentValue := i.resolveMemberExpression(me)
-	if n.Operator == "++" {
-		update := lang.NewInt(currentValue.Int + 1)
-		o.SetProperty(property, update)
-		return update
-	} else if n.Operator == "--" {
-		update := lang.NewInt(currentValue.Int - 1)
-		o.SetProperty(property, update)
-		return update
-	} else {
+// https://tc39.es/ecma262/#sec-delete-operator-runtime-semantics-evaluation
+func (i *Interpreter) deleteExpression(n *ast.UnaryExpression, k cont) step {
+	switch argument := ast.Unparenthesized(n.Argument).(type) {
+	case *ast.MemberExpression:
+		return i.memberReference(argument, func(ref reference) step {
+			if ref.super {
+				lang.ThrowReferenceError("Unsupported reference to 'super'")
+			}
+			return k(lang.NewBool(i.deleteProperty(ref.base, ref.key)))
+		})
+	case *ast.ChainExpression:
+		member, ok := argument.Expression.(*ast.MemberExpression)
+		if !ok {
+			return i.eval(argument, func(lang.Value) step {
+				return k(lang.NewBool(true))
+			})
+		}
+
+		return i.evaluateChain(member.Object, func(base, _ lang.Value, ok bool) step {
+			if !ok || (member.Optional && isNullish(base)) {
+				return k(lang.NewBool(true))
+			}
+			return i.propertyKey(member.Property, member.Computed, func(key lang.PropertyKey) step {
+				return k(lang.NewBool(i.deleteProperty(base, key)))
+			})
+		})
+	case *ast.Identifier:
+		// Variable bindings cannot be deleted, unresolvable references can.
+		_, ok := i.lookup(argument.Name)
+		return k(lang.NewBool(!ok))
+	default:
+		return i.eval(n.Argument, func(lang.Value) step {
+			return k(lang.NewBool(true))
+		})
+	}
+}
+
+// deleteProperty deletes a property of the base value. Strict mode code
+// throws when the property cannot be deleted.
+func (i *Interpreter) deleteProperty(base lang.Value, key lang.PropertyKey) bool {
+	ok := key.Delete(i.realm.ToObject(base))
+	if !ok && i.strict {
+		lang.ThrowTypeError("Cannot delete property '%s' of %s", key.String(), base.String())
+	}
+	return ok
+}
+
+// https://tc39.es/ecma262/#sec-update-expressions
+func (i *Interpreter) updateExpression(n *ast.UpdateExpression, k cont) step {
+	return i.resolveReference(n.Argument, func(ref reference) step {
+		return k(i.updateReference(n, ref))
+	})
+}
+
+func (i *Interpreter) updateReference(n *ast.UpdateExpression, ref reference) lang.Value {
+	old := lang.ToNumeric(i.getValue(ref))
+
+	one := lang.NewNumber(1)
+	if old.Type == lang.ValueTypeBigInt {
+		one = lang.NewBigInt(big.NewInt(1))
+	}
+
+	var update lang.Value
+	switch n.Operator {
+	case "++":
+		update = lang.ApplyStringOrNumericBinaryOperator(old, "+", one)
+	case "--":
+		update = lang.ApplyStringOrNumericBinaryOperator(old, "-", one)
+	default:
 		panic("unsupported operation")
 	}
 
+	i.putValue(ref, update)
+	if n.Prefix {
+		return update
+	}
+	return old
+}
+
+// https://tc39.es/ecma262/#sec-runtime-semantics-instantiatefunctionobject
+func (i *Interpreter) instantiateFunctionObject(n *ast.FunctionDeclaration) *lang.Function {
+	f := i.newFunction(n.Generator, lang.FunctionKindNormal, n.Id.Name, n.Body, n.Parameters, n.SourceText)
+	f.Strict = n.Strict || i.strict
+	f.Environment, f.PrivateEnvironment = i.closure(), i.frame.privateEnv
+	return f
 }
 
-func (i *Interpreter) updateExpression(n *ast.UpdateExpression) lang.Value {
-	if identifier, ok := n.Argument.(*ast.Identifier); ok {
-		return i.identifierUpdateExpression(n, identifier)
+// https://tc39.es/ecma262/#sec-function-definitions-runtime-semantics-evaluation
+func (i *Interpreter) functionExpression(n *ast.FunctionExpression) lang.Value {
+	name := ""
+	if n.Id != nil {
+		name = n.Id.Name
 	}
+	return lang.NewObj(i.functionValue(n, lang.FunctionKindNormal, name))
+}
 
-	if member, ok := n.Argument.(*ast.MemberExpression); ok {
-		return i.memberUpdateExpression(n, member)
+// functionValue creates the function object of a function expression or
+// method with the given name.
+func (i *Interpreter) functionValue(n *ast.FunctionExpression, kind lang.FunctionKind, name string) *lang.Function {
+	f := i.newFunction(n.Generator, kind, name, n.Body, n.Parameters, n.SourceText)
+	f.Strict = n.Strict || i.strict
+	f.Environment, f.PrivateEnvironment = i.closure(), i.frame.privateEnv
+	return f
+}
+
+// closure returns the scopes of the running code, which a function created
+// by it evaluates its body with. The scopes themselves are shared, so the
+// function sees the bindings they get later.
+// https://tc39.es/ecma262/#sec-ecmascript-function-objects
+func (i *Interpreter) closure() []scope {
+	return append([]scope(nil), i.scope...)
+}
+
+// enterClosure replaces the scopes of the running code with the scopes the
+// function was created with and a new scope for its own bindings, returning a
+// function that restores them.
+func (i *Interpreter) enterClosure(f *lang.Function) (restore func()) {
+	scopes := i.scope
+	env, ok := f.Environment.([]scope)
+	if !ok {
+		env = scopes[:1]
 	}
 
-	panic("unsupported expression type")
+	i.scope = append(env[:len(env):len(env)], newScope())
+	return func() {
+		i.scope = scopes
+	}
+}
 
+// https://tc39.es/ecma262/#sec-runtime-semantics-instantiateordinaryfunctionobject
+// https://tc39.es/ecma262/#sec-runtime-semantics-instantiategeneratorfunctionobject
+func (i *Interpreter) newFunction(generator bool, kind lang.FunctionKind, name string, body ast.Statement, parameters []ast.Pattern, sourceText string) *lang.Function {
+	if generator {
+		return i.realm.NewGeneratorFunction(kind, name, body, parameters, sourceText)
+	}
+	return i.realm.NewFunction(kind, name, body, parameters, sourceText)
 }
 
-func (i *Interpreter) functionDeclaration(n *ast.FunctionDeclaration) lang.Value {
-	f := lang.NewObj(&lang.Function{Name: n.Id.Name, Body: n.Body, Parameters: n.Parameters})
-	i.put(n.Id.Name, f)
+// methodValue creates the function object of a method, getter or setter,
+// whose super property references are looked up from the home object.
+// https://tc39.es/ecma262/#sec-makemethod
+func (i *Interpreter) methodValue(n *ast.FunctionExpression, name string, home lang.Object) *lang.Function {
+	f := i.functionValue(n, lang.FunctionKindMethod, name)
+	f.HomeObject = home
 	return f
 }
 
-func (i *Interpreter) returnStatement(n *ast.ReturnStatement) lang.Value {
-	return i.Do(n.Argument)
+// returnCompletion is the panic value used to unwind the evaluation of a
+// function body from a return statement.
+type returnCompletion struct {
+	value lang.Value
+}
+
+// https://tc39.es/ecma262/#sec-return-statement-runtime-semantics-evaluation
+func (i *Interpreter) returnStatement(n *ast.ReturnStatement) step {
+	if n.Argument == nil {
+		panic(&returnCompletion{value: lang.NewUndefined()})
+	}
+
+	return i.eval(n.Argument, func(value lang.Value) step {
+		panic(&returnCompletion{value: value})
+	})
 }
 
-func (i *Interpreter) variableDeclaration(n *ast.VariableDeclaration) lang.Value {
+func (i *Interpreter) variableDeclaration(n *ast.VariableDeclaration, k cont) step {
 	lv := lang.Value{}
-	for _, d := range n.Declarations {
-		lv = i.Do(d)
+	return each(len(n.Declarations), func(idx int, next func() step) step {
+		return i.variableDeclarator(n.Declarations[idx], n.Kind, func(v lang.Value) step {
+			lv = v
+			return next()
+		})
+	}, func() step {
+		return k(lv)
+	})
+}
+
+// https://tc39.es/ecma262/#sec-with-statement-runtime-semantics-evaluation
+func (i *Interpreter) withStatement(n *ast.WithStatement, k cont) step {
+	return i.eval(n.Object, func(v lang.Value) step {
+		i.scope = append(i.scope, newObjectScope(i.realm.ToObject(v)))
+		return i.eval(n.Body, func(v lang.Value) step {
+			i.exitScope()
+			return k(v)
+		})
+	})
+}
+
+// A let or const declaration in the head of a for statement is scoped to the
+// loop, and each iteration gets its own copy of the let bindings, which the
+// functions created in the iteration keep.
+// https://tc39.es/ecma262/#sec-for-statement-runtime-semantics-forloopevaluation
+func (i *Interpreter) forStatement(n *ast.ForStatement, k cont) step {
+	var perIteration []string
+	lexical := false
+	if d, ok := n.Init.(*ast.VariableDeclaration); ok && d.Kind != "var" {
+		lexical = true
+		i.enterScope()
+		i.uninitialize(ast.LexicallyDeclaredNames(d, false))
+		if d.Kind == "let" {
+			perIteration = ast.LexicallyDeclaredNames(d, false)
+		}
 	}
-	return lv
+
+	return i.eval(n.Init, func(lang.Value) step {
+		i.createPerIterationEnvironment(perIteration)
+		var iterate func() step
+		iterate = func() step {
+			return i.eval(n.Test, func(test lang.Value) step {
+				if !lang.ToBoolean(test) {
+					if lexical {
+						i.exitScope()
+					}
+					return k(lang.NewUndefined())
+				}
+
+				return i.eval(n.Body, func(lang.Value) step {
+					i.createPerIterationEnvironment(perIteration)
+					return i.eval(n.Update, func(lang.Value) step {
+						return step{next: iterate}
+					})
+				})
+			})
+		}
+		return iterate()
+	})
+}
+
+// createPerIterationEnvironment replaces the scope of a for statement with a
+// new one holding the current values of its let bindings.
+// https://tc39.es/ecma262/#sec-createperiterationenvironment
+func (i *Interpreter) createPerIterationEnvironment(names []string) {
+	if len(names) == 0 {
+		return
+	}
+
+	// The bindings are initialized by now, and a let declaration makes none
+	// immutable, so the copy shares the rest of the scope.
+	last := i.scope[len(i.scope)-1]
+	vars := make(map[string]lang.Value, len(names))
+	for _, name := range names {
+		vars[name] = last.vars[name]
+	}
+	i.scope[len(i.scope)-1] = scope{vars: vars, constants: last.constants, uninitialized: last.uninitialized}
+}
+
+// for-in enumerates nothing for undefined and null.
+// https://tc39.es/ecma262/#sec-runtime-semantics-forinofheadevaluation
+func (i *Interpreter) forInStatement(n *ast.ForInStatement, k cont) step {
+	return i.forInOfHead(n.Left, n.Right, func(value lang.Value) step {
+		if isNullish(value) {
+			return k(lang.NewUndefined())
+		}
+
+		it := lang.CreateForInIterator(i.realm.ToObject(value))
+		var iterate func() step
+		iterate = func() step {
+			key, ok := it.Next()
+			if !ok {
+				return k(lang.NewUndefined())
+			}
+
+			return i.bindForInOf(n.Left, lang.NewStr(key), n.Body, func(lang.Value) step {
+				return step{next: iterate}
+			})
+		}
+		return iterate()
+	})
 }
 
-func (i *Interpreter) expressionStatement(n *ast.ExpressionStatement) lang.Value {
-	return i.Do(n.Expression)
+// The iterator is closed when binding the value or evaluating the body
+// completes abruptly.
+// https://tc39.es/ecma262/#sec-runtime-semantics-forin-div-ofbodyevaluation-lhs-stmt-iterator-lhskind-labelset
+func (i *Interpreter) forOfStatement(n *ast.ForOfStatement, k cont) step {
+	return i.forInOfHead(n.Left, n.Right, func(iterable lang.Value) step {
+		ir := i.realm.GetIterator(iterable)
+		var iterate func() step
+		iterate = func() step {
+			value, ok := ir.StepValue()
+			if !ok {
+				return k(lang.NewUndefined())
+			}
+
+			i.iterators = append(i.iterators, ir)
+			return i.bindForInOf(n.Left, value, n.Body, func(lang.Value) step {
+				i.iterators = i.iterators[:len(i.iterators)-1]
+				return step{next: iterate}
+			})
+		}
+		return iterate()
+	})
 }
 
-func (i *Interpreter) forStatement(n *ast.ForStatement) lang.Value {
+// forInOfHead evaluates the expression of a for-in or for-of statement. The
+// names a let or const declaration on the left-hand side binds are in their
+// temporal dead zone while it is evaluated.
+// https://tc39.es/ecma262/#sec-runtime-semantics-forinofheadevaluation
+func (i *Interpreter) forInOfHead(left ast.Node, right ast.Expression, k cont) step {
+	d, ok := left.(*ast.VariableDeclaration)
+	if !ok || d.Kind == "var" {
+		return i.eval(right, k)
+	}
+
 	i.enterScope()
-	i.Do(n.Init)
-	for i.Do(n.Test).Bool {
-		i.Do(n.Body)
-		i.Do(n.Update)
+	i.uninitialize(ast.LexicallyDeclaredNames(d, false))
+	return i.eval(right, func(v lang.Value) step {
+		i.exitScope()
+		return k(v)
+	})
+}
+
+// bindForInOf binds the left-hand side of a for-in or for-of statement,
+// which is a variable declaration or an assignment target, and evaluates the
+// body. A let or const declaration is bound in a new scope for each
+// iteration.
+// https://tc39.es/ecma262/#sec-runtime-semantics-forin-div-ofbodyevaluation-lhs-stmt-iterator-lhskind-labelset
+func (i *Interpreter) bindForInOf(left ast.Node, value lang.Value, body ast.Statement, k cont) step {
+	declaration, ok := left.(*ast.VariableDeclaration)
+	if !ok {
+		return i.bindPattern(left.(ast.Pattern), value, false, func(lang.Value) step {
+			return i.eval(body, k)
+		})
 	}
-	i.exitScope()
-	return lang.NewUndefined()
+
+	id := declaration.Declarations[0].Id
+	if declaration.Kind == "var" {
+		return i.bindPattern(id, value, false, func(lang.Value) step {
+			return i.eval(body, k)
+		})
+	}
+
+	i.enterScope()
+	i.uninitialize(ast.BoundNames(id))
+	return i.bindPattern(id, value, true, func(lang.Value) step {
+		i.declareConstants(id, declaration.Kind)
+		return i.eval(body, func(v lang.Value) step {
+			i.exitScope()
+			return k(v)
+		})
+	})
 }
 
-func (i *Interpreter) callExpression(n *ast.CallExpression) lang.Value {
+// https://tc39.es/ecma262/#sec-conditional-operator-runtime-semantics-evaluation
+func (i *Interpreter) conditionalExpression(n *ast.ConditionalExpression, k cont) step {
+	return i.eval(n.Test, func(test lang.Value) step {
+		if lang.ToBoolean(test) {
+			return i.eval(n.Consequent, k)
+		}
+		return i.eval(n.Alternate, k)
+	})
+}
+
+// https://tc39.es/ecma262/#sec-comma-operator-runtime-semantics-evaluation
+func (i *Interpreter) sequenceExpression(n *ast.SequenceExpression, k cont) step {
+	var value lang.Value
+	return each(len(n.Expressions), func(idx int, next func() step) step {
+		return i.eval(n.Expressions[idx], func(v lang.Value) step {
+			value = v
+			return next()
+		})
+	}, func() step {
+		return k(value)
+	})
+}
+
+func (i *Interpreter) callExpression(n *ast.CallExpression, k cont) step {
+	if _, ok := n.Callee.(*ast.Super); ok {
+		return i.superCall(n, k)
+	}
+
+	return i.evaluateCallee(n.Callee, func(f, this lang.Value) step {
+		return i.evaluateArguments(n.Arguments, func(args []lang.Value) step {
+			return k(lang.Call(f, this, args...))
+		})
+	})
+}
+
+// https://tc39.es/ecma262/#sec-new-operator-runtime-semantics-evaluation
+func (i *Interpreter) newExpression(n *ast.NewExpression, k cont) step {
+	return i.eval(n.Callee, func(constructor lang.Value) step {
+		return i.evaluateArguments(n.Arguments, func(args []lang.Value) step {
+			if !lang.IsConstructor(constructor) {
+				lang.ThrowTypeError("%s is not a constructor", constructor.String())
+			}
+
+			return k(lang.Construct(constructor, args, nil))
+		})
+	})
+}
+
+// https://tc39.es/ecma262/#sec-runtime-semantics-argumentlistevaluation
+func (i *Interpreter) evaluateArguments(arguments []ast.Expression, k func(args []lang.Value) step) step {
 	args := []lang.Value{}
-	for _, a := range n.Arguments {
-		args = append(args, i.Do(a))
+	return each(len(arguments), func(idx int, next func() step) step {
+		spread, ok := arguments[idx].(*ast.SpreadElement)
+		if !ok {
+			return i.eval(arguments[idx], func(v lang.Value) step {
+				args = append(args, v)
+				return next()
+			})
+		}
+
+		return i.eval(spread.Argument, func(iterable lang.Value) step {
+			ir := i.realm.GetIterator(iterable)
+			for value, ok := ir.StepValue(); ok; value, ok = ir.StepValue() {
+				args = append(args, value)
+			}
+			return next()
+		})
+	}, func() step {
+		return k(args)
+	})
+}
+
+// https://tc39.es/ecma262/#sec-optional-chaining-evaluation
+func (i *Interpreter) chainExpression(n *ast.ChainExpression, k cont) step {
+	return i.evaluateChain(n.Expression, func(value, _ lang.Value, _ bool) step {
+		return k(value)
+	})
+}
+
+// evaluateChain evaluates an element of an optional chain, along with the
+// this value for calling the result. It continues with false, and an
+// undefined value, once an optional member or call short-circuits the chain.
+// https://tc39.es/ecma262/#sec-optional-chains
+func (i *Interpreter) evaluateChain(n ast.Expression, k func(value, this lang.Value, ok bool) step) step {
+	switch n := n.(type) {
+	case *ast.MemberExpression:
+		return i.evaluateChain(n.Object, func(base, _ lang.Value, ok bool) step {
+			if !ok || (n.Optional && isNullish(base)) {
+				return k(lang.NewUndefined(), lang.NewUndefined(), false)
+			}
+			return i.propertyReference(base, n, func(ref reference) step {
+				return k(i.getValue(ref), base, true)
+			})
+		})
+	case *ast.CallExpression:
+		return i.evaluateChain(n.Callee, func(f, this lang.Value, ok bool) step {
+			if !ok || (n.Optional && isNullish(f)) {
+				return k(lang.NewUndefined(), lang.NewUndefined(), false)
+			}
+			return i.evaluateArguments(n.Arguments, func(args []lang.Value) step {
+				return k(lang.Call(f, this, args...), lang.NewUndefined(), true)
+			})
+		})
+	default:
+		return i.eval(n, func(v lang.Value) step {
+			return k(v, lang.NewUndefined(), true)
+		})
 	}
+}
+
+func isNullish(v lang.Value) bool {
+	return v.Type == lang.ValueTypeUndefined || v.Type == lang.ValueTypeNull
+}
 
-	v := lang.NewUndefined()
+// evaluateCallee evaluates the function of a call along with the this value
+// it is called with, which is the base of a property reference.
+// https://tc39.es/ecma262/#sec-evaluatecall
+func (i *Interpreter) evaluateCallee(callee ast.Expression, k func(f, this lang.Value) step) step {
+	switch callee := ast.Unparenthesized(callee).(type) {
+	case *ast.MemberExpression:
+		return i.memberReference(callee, func(ref reference) step {
+			return k(i.getValue(ref), ref.thisValue())
+		})
+	case *ast.ChainExpression:
+		return i.evaluateChain(callee.Expression, func(f, this lang.Value, _ bool) step {
+			return k(f, this)
+		})
+	}
 
-	f := i.get(n.Callee.Name)
-	if nf, ok := f.Obj.(*lang.NativeFunction); ok {
-		nf.Function(args...)
-	} else if lf, ok := f.Obj.(*lang.Function); ok {
+	return i.eval(callee, func(f lang.Value) step {
+		return k(f, lang.NewUndefined())
+	})
+}
+
+// https://tc39.es/ecma262/#sec-template-literals-runtime-semantics-evaluation
+func (i *Interpreter) templateLiteral(n *ast.TemplateLiteral, k cont) step {
+	parts := make([]string, 0, len(n.Quasis)+len(n.Expressions))
+	return each(len(n.Quasis), func(idx int, next func() step) step {
+		parts = append(parts, n.Quasis[idx].Cooked)
+		if idx == len(n.Expressions) {
+			return next()
+		}
+		return i.eval(n.Expressions[idx], func(v lang.Value) step {
+			parts = append(parts, lang.ToString(v))
+			return next()
+		})
+	}, func() step {
+		return k(lang.NewStr(lang.ConcatStrings(parts...)))
+	})
+}
+
+// https://tc39.es/ecma262/#sec-tagged-templates-runtime-semantics-evaluation
+func (i *Interpreter) taggedTemplateExpression(n *ast.TaggedTemplateExpression, k cont) step {
+	return i.evaluateCallee(n.Tag, func(f, this lang.Value) step {
+		return i.expressions(n.Quasi.Expressions, func(values []lang.Value) step {
+			args := append([]lang.Value{lang.NewObj(i.realm.GetTemplateObject(n.Quasi))}, values...)
+			return k(lang.Call(f, this, args...))
+		})
+	})
+}
+
+// CallFunction evaluates the body of a script function.
+// https://tc39.es/ecma262/#sec-ecmascript-function-objects-call-thisargument-argumentslist
+func (i *Interpreter) CallFunction(f *lang.Function, this lang.Value, args []lang.Value) lang.Value {
+	if f.IsClassConstructor {
+		lang.ThrowTypeError("Class constructor %s cannot be invoked without 'new'", f.Name)
+	}
+
+	result, _ := i.call(f, frame{this: i.thisValue(f, this), thisBound: true}, args)
+	return result
+}
+
+// ConstructFunction evaluates the body of a script function called with new.
+// Derived constructors leave allocating the this value to the parent
+// constructor, which super calls.
+// https://tc39.es/ecma262/#sec-ecmascript-function-objects-construct-argumentslist-newtarget
+func (i *Interpreter) ConstructFunction(f *lang.Function, args []lang.Value, newTarget lang.Object) lang.Value {
+	fr := frame{newTarget: newTarget}
+	if f.ConstructorKind == lang.ConstructorKindBase {
+		this := lang.NewJsObject(lang.GetPrototypeFromConstructor(newTarget, i.realm.ObjectPrototype))
+		fr.this, fr.thisBound = lang.NewObj(this), true
+		i.initializeInstanceElements(this, f)
+	}
+
+	result, fr := i.call(f, fr, args)
+	if result.Type == lang.ValueTypeObj {
+		return result
+	}
+	if f.ConstructorKind == lang.ConstructorKindBase {
+		return fr.this
+	}
+
+	if result.Type != lang.ValueTypeUndefined {
+		lang.ThrowTypeError("Derived constructors may only return object or undefined")
+	}
+	if !fr.thisBound {
+		lang.ThrowReferenceError("Must call super constructor in derived class before accessing 'this' or returning from derived constructor")
+	}
+	return fr.this
+}
+
+// call evaluates the body of a script function in a new frame, returning
+// the frame as it was when the body completed. The body of a generator
+// function is left to the generator it returns instead.
+// https://tc39.es/ecma262/#sec-functiondeclarationinstantiation
+func (i *Interpreter) call(f *lang.Function, fr frame, args []lang.Value) (lang.Value, frame) {
+	defer i.enterClosure(f)()
+
+	defer func(strict bool, fr frame) {
+		i.strict, i.frame = strict, fr
+	}(i.strict, i.frame)
+
+	fr.function, fr.homeObject, fr.privateEnv = f, f.HomeObject, f.PrivateEnvironment
+	i.strict, i.frame = f.Strict, fr
+
+	// https://tc39.es/ecma262/#sec-runtime-semantics-classdefinitionevaluation
+	if f.Body == nil {
+		if f.ConstructorKind == lang.ConstructorKindDerived {
+			i.constructSuper(f.GetPrototypeOf(), args)
+		}
+		return lang.NewUndefined(), i.frame
+	}
+
+	var parameterNames []string
+	for _, p := range f.Parameters {
+		parameterNames = append(parameterNames, ast.BoundNames(p)...)
+	}
+
+	if !containsName(parameterNames, "arguments") {
+		i.put("arguments", lang.NewObj(i.createArgumentsObject(f, parameterNames, args)))
+	}
+
+	// Parameters with expressions in their list are in their temporal dead
+	// zone until they are bound.
+	if ast.ContainsExpression(f.Parameters) {
+		i.uninitialize(parameterNames)
+	}
+
+	for idx, p := range f.Parameters {
+		target, value := p, lang.NewUndefined()
+		if rest, ok := p.(*ast.RestElement); ok {
+			var values []lang.Value
+			if idx < len(args) {
+				values = append(values, args[idx:]...)
+			}
+			target, value = rest.Argument, lang.NewObj(i.realm.NewArray(values))
+		} else if idx < len(args) {
+			value = args[idx]
+		}
+
+		i.evaluate(func(k cont) step {
+			return i.bindPattern(target, value, true, k)
+		})
+	}
+
+	// Parameter expressions are evaluated in their own scope, so the body
+	// gets a separate one that starts out with the values of the parameters.
+	if ast.ContainsExpression(f.Parameters) {
+		parameters := i.latestScope()
 		i.enterScope()
-		for idx, a := range lf.Parameters {
-			i.put(a.Name, args[idx])
+		defer i.exitScope()
+
+		for name, value := range parameters.vars {
+			i.put(name, value)
 		}
-		v = i.Do(lf.Body)
-		i.exitScope()
-	} else {
-		panic("unhandled function reference type")
 	}
+	i.instantiateDeclarations(bodyStatements(f.Body), true)
 
-	return v
+	if f.Generator {
+		return i.generatorStart(f), i.frame
+	}
+	return i.evaluateBody(f.Body), i.frame
 }
 
-func (i *Interpreter) intLiteral(n *ast.IntLiteral) lang.Value {
-	return lang.NewInt(n.Value)
+// evaluateBody evaluates a function body, returning the value of the return
+// statement that completes it, or undefined when it runs to its end.
+// https://tc39.es/ecma262/#sec-runtime-semantics-evaluatebody
+func (i *Interpreter) evaluateBody(body ast.Statement) (result lang.Value) {
+	defer func() {
+		if r := recover(); r != nil {
+			completion, ok := r.(*returnCompletion)
+			if !ok {
+				panic(r)
+			}
+			result = completion.value
+		}
+	}()
+
+	i.evaluate(func(k cont) step {
+		return i.statements(bodyStatements(body), k)
+	})
+	return lang.NewUndefined()
 }
 
-func (i *Interpreter) memberExpression(n *ast.MemberExpression) lang.Value {
-	_, _, val := i.resolveMemberExpression(n)
-	return val
+// bodyStatements returns the statements of a function body, whose
+// declarations are instantiated in the scope of the call rather than a
+// block scope of their own.
+func bodyStatements(body ast.Statement) []ast.Statement {
+	return body.(*ast.BlockStatement).Body
 }
 
-func (i *Interpreter) resolveMemberExpression(n *ast.MemberExpression) (lang.Object, string, lang.Value) {
-	o := i.Do(n.Object)
-	if o.Type != lang.ValueTypeObj {
-		panic("not an object")
+// thisValue returns the this value a function is called with. Sloppy mode
+// functions see the global object in place of undefined or null, and objects
+// in place of primitives.
+// https://tc39.es/ecma262/#sec-ordinarycallbindthis
+func (i *Interpreter) thisValue(f *lang.Function, this lang.Value) lang.Value {
+	if f.Strict {
+		return this
 	}
 
-	var name string
-	if identifier, ok := n.Property.(*ast.Identifier); ok {
-		name = identifier.Name
-	} else {
-		property := i.Do(n.Property)
-		// TODO: String() is too lenient.
-		name = property.String()
+	if isNullish(this) {
+		return lang.NewObj(i.realm.GlobalObject)
 	}
+	return lang.NewObj(i.realm.ToObject(this))
+}
 
-	return o.Obj, name, o.Obj.GetProperty(name)
+// createArgumentsObject creates the arguments object of a call. Functions
+// with simple parameter lists get a mapped arguments object, whose indices
+// alias the parameters in the current scope.
+func (i *Interpreter) createArgumentsObject(f *lang.Function, parameterNames []string, args []lang.Value) *lang.ArgumentsObject {
+	if f.Strict || !ast.IsSimpleParameterList(f.Parameters) {
+		return i.realm.CreateUnmappedArgumentsObject(args)
+	}
+
+	s := i.latestScope()
+	return i.realm.CreateMappedArgumentsObject(f, parameterNames, args, func(name string) lang.ParameterBinding {
+		return lang.ParameterBinding{
+			Get: func() lang.Value {
+				v, _ := s.Get(name)
+				return v
+			},
+			Set: func(v lang.Value) {
+				s.Put(name, v)
+			},
+		}
+	})
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *Interpreter) numericLiteral(n *ast.NumericLiteral) lang.Value {
+	return lang.NewNumber(n.Value)
+}
+
+func (i *Interpreter) stringLiteral(n *ast.StringLiteral) lang.Value {
+	return lang.NewStr(n.Value)
+}
+
+// https://tc39.es/ecma262/#sec-regular-expression-literals-runtime-semantics-evaluation
+func (i *Interpreter) regExpLiteral(n *ast.RegExpLiteral) lang.Value {
+	return lang.NewObj(i.realm.NewRegExpObject(n.Pattern, n.Flags))
+}
+
+func (i *Interpreter) memberExpression(n *ast.MemberExpression, k cont) step {
+	return i.memberReference(n, func(ref reference) step {
+		return k(i.getValue(ref))
+	})
+}
+
+// memberReference evaluates the base value and property key of a property
+// reference.
+// https://tc39.es/ecma262/#sec-property-accessors-runtime-semantics-evaluation
+func (i *Interpreter) memberReference(n *ast.MemberExpression, k func(ref reference) step) step {
+	if _, ok := n.Object.(*ast.Super); ok {
+		return i.superReference(n, k)
+	}
+	return i.eval(n.Object, func(base lang.Value) step {
+		return i.propertyReference(base, n, k)
+	})
+}
+
+// propertyReference evaluates the property key of a member of the base
+// value, which may be a private name.
+// https://tc39.es/ecma262/#sec-evaluate-property-access-with-identifier-key
+func (i *Interpreter) propertyReference(base lang.Value, n *ast.MemberExpression, k func(ref reference) step) step {
+	if private, ok := n.Property.(*ast.PrivateIdentifier); ok {
+		return k(reference{base: base, privateName: i.frame.privateEnv.Resolve(private.Name), property: true})
+	}
+	return i.propertyKey(n.Property, n.Computed, func(key lang.PropertyKey) step {
+		return k(reference{base: base, key: key, property: true})
+	})
+}
+
+// reference is a resolved binding or property reference, evaluated once so it
+// can be both read and written. Super references look the property up on the
+// prototype of the home object, using this as the receiver, and private
+// references have a private name in place of a property key.
+// https://tc39.es/ecma262/#sec-reference-record-specification-type
+type reference struct {
+	base     lang.Value
+	name     string
+	key      lang.PropertyKey
+	property bool
+
+	privateName *lang.PrivateName
+	super       bool
+	this        lang.Value
+}
+
+// thisValue returns the this value a function read through the reference is
+// called with.
+// https://tc39.es/ecma262/#sec-getthisvalue
+func (r reference) thisValue() lang.Value {
+	if r.super {
+		return r.this
+	}
+	return r.base
+}
+
+func (i *Interpreter) resolveReference(n ast.Expression, k func(ref reference) step) step {
+	switch n := ast.Unparenthesized(n).(type) {
+	case *ast.Identifier:
+		return k(reference{name: n.Name})
+	case *ast.MemberExpression:
+		return i.memberReference(n, k)
+	default:
+		panic("invalid assignment target")
+	}
+}
+
+// https://tc39.es/ecma262/#sec-getvalue
+func (i *Interpreter) getValue(ref reference) lang.Value {
+	switch {
+	case ref.privateName != nil:
+		return lang.PrivateGet(i.realm.ToObject(ref.base), ref.privateName)
+	case ref.property && !ref.super:
+		return i.realm.GetV(ref.base, ref.key)
+	case ref.property:
+		return ref.key.Get(i.realm.ToObject(ref.base), ref.thisValue())
+	default:
+		return i.get(ref.name)
+	}
+}
+
+// https://tc39.es/ecma262/#sec-putvalue
+func (i *Interpreter) putValue(ref reference, value lang.Value) {
+	switch {
+	case ref.privateName != nil:
+		lang.PrivateSet(i.realm.ToObject(ref.base), ref.privateName, value)
+	case ref.property:
+		if !ref.key.Set(i.realm.ToObject(ref.base), value, ref.thisValue()) && i.strict {
+			lang.ThrowTypeError("Cannot assign to read only property '%s' of %s", ref.key.String(), ref.base.String())
+		}
+	default:
+		i.set(ref.name, value)
+	}
 }
@@ -0,0 +1,325 @@
+package intp
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"gojs/lang"
+	"gojs/parse"
+	"gojs/tkn"
+)
+
+func run(t *testing.T, src string) string {
+	t.Helper()
+
+	var out []string
+	i := NewInterpreter()
+	i.BindNativeFunction("print", func(values ...lang.Value) {
+		var s []string
+		for _, v := range values {
+			s = append(s, v.String())
+		}
+		out = append(out, strings.Join(s, " "))
+	})
+
+	p := parse.NewStreamParser(tkn.NewTokenizerBytes([]byte(src)), parse.WithSource(src))
+	program := p.Parse()
+	i.Do(&program)
+	return strings.Join(out, "\n")
+}
+
+// throws runs the source, returning what it panics with.
+func throws(t *testing.T, src string) (message string) {
+	t.Helper()
+
+	defer func() {
+		message = fmt.Sprint(recover())
+	}()
+	run(t, src)
+	return ""
+}
+
+func TestVariableDeclaration(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "const pattern",
+			src:  `var obj = { a: 1, b: [2] }; const {a, b: [c]} = obj; print(a, c)`,
+			want: "1 2",
+		},
+		{
+			name: "let",
+			src:  `let x = 1, [y, ...z] = [2, 3, 4]; print(x, y, z.length)`,
+			want: "1 2 2",
+		},
+		{
+			name: "let without initializer",
+			src:  `for (var i = 0; i < 2; i++) { let x; print(x); x = i }`,
+			want: "undefined\nundefined",
+		},
+		{
+			name: "let identifier",
+			src:  `var let = 1; let = let + 1; print(let)`,
+			want: "2",
+		},
+		{
+			name: "loop heads",
+			src:  `for (const v of [1, 2]) print(v); for (let k in { a: 1 }) print(k); for (let i = 0; i < 1; i++) print(i)`,
+			want: "1\n2\na\n0",
+		},
+		{
+			name: "block scope",
+			src:  `let x = 1; { let x = 2; const y = 3; print(x, y) } { var v = 4 } print(x, typeof y, v)`,
+			want: "2 3\n1 undefined 4",
+		},
+		{
+			name: "hoisting",
+			src:  `print(typeof f, v); function f() {} var v = 1; { print(g()); function g() { return "g" } } print(typeof g)`,
+			want: "function undefined\ng\nundefined",
+		},
+		{
+			name: "per-iteration bindings",
+			src:  `var fs = []; for (let i = 0; i < 3; i++) { fs[i] = { f() { return i } } } for (const k of [3, 4]) { fs[k] = { f() { return k } } } print(fs[0].f(), fs[2].f(), fs[3].f(), fs[4].f())`,
+			want: "0 2 3 4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := run(t, tt.src); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVariableDeclarationErrors(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{src: `const x = 1; x = 2`, want: "Assignment to constant variable 'x'"},
+		{src: `const {x} = {x: 1}; x++`, want: "Assignment to constant variable 'x'"},
+		{src: `const x;`, want: "missing initializer in const declaration"},
+		{src: `let [x];`, want: "missing initializer in destructuring declaration"},
+		{src: `let let = 1`, want: "let is disallowed as a lexically bound name"},
+		{src: `let x; let x`, want: "identifier 'x' has already been declared"},
+		{src: `var x; { let y; var y }`, want: "identifier 'y' has already been declared"},
+		{src: `function f(a) { let a }`, want: "identifier 'a' has already been declared"},
+		{src: `for (let i of []) { var i }`, want: "identifier 'i' has already been declared"},
+		{src: `if (true) let x = 1`, want: "lexical declaration cannot appear in a single-statement context"},
+		{src: `print(typeof x); let x`, want: "Cannot access 'x' before initialization"},
+		{src: `function f() { return x } f(); const x = 1`, want: "Cannot access 'x' before initialization"},
+		{src: `for (let x of [x]) {}`, want: "Cannot access 'x' before initialization"},
+		{src: `class C extends C {}`, want: "Cannot access 'C' before initialization"},
+		{src: `function f(a = b, b) {} f()`, want: "Cannot access 'b' before initialization"},
+	}
+
+	for _, tt := range tests {
+		if got := throws(t, tt.src); !strings.Contains(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestClassBinding(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "methods",
+			src:  `var C = class Named { static n() { return Named.name } m() { return Named === C } }; print(C.n(), new C().m())`,
+			want: "Named true",
+		},
+		{
+			name: "fields",
+			src:  `var C = class Named { f = Named.name; static s = Named.name }; print(new C().f, C.s)`,
+			want: "Named Named",
+		},
+		{
+			name: "nested function",
+			src:  `var C = class Named { static g() { function h() { return Named.name } return h() } }; print(C.g())`,
+			want: "Named",
+		},
+		{
+			name: "not visible outside",
+			src:  `var C = class Named {}; print(typeof Named)`,
+			want: "undefined",
+		},
+		{
+			name: "shadowed by parameter",
+			src:  `var C = class Named { static p(Named) { return Named } }; print(C.p(5))`,
+			want: "5",
+		},
+		{
+			name: "declaration out of scope",
+			src:  `function make() { class Inner { static who() { return Inner.name } } return Inner } print(make().who())`,
+			want: "Inner",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := run(t, tt.src); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAssignmentNames(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "assignment",
+			src:  `var e; e = class {}; print(e.name)`,
+			want: "e",
+		},
+		{
+			name: "logical assignment",
+			src:  `var a, b = 1, c = null; a ||= class {}; b &&= class {}; c ??= class {}; print(a.name, b.name, c.name)`,
+			want: "a b c",
+		},
+		{
+			name: "short-circuited",
+			src:  `var d = 1; d ||= class {}; print(d)`,
+			want: "1",
+		},
+		{
+			name: "parenthesized target",
+			src:  `var p; (p) = class {}; print(p.name === "")`,
+			want: "true",
+		},
+		{
+			name: "member target",
+			src:  `var o = {}; o.x = class {}; print(o.x.name === "")`,
+			want: "true",
+		},
+		{
+			name: "named class",
+			src:  `var n; n = class Named {}; print(n.name)`,
+			want: "Named",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := run(t, tt.src); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClosures(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "method",
+			src:  `function mk() { var i = 0; return { next() { return i++ } } } var it = mk(); it.next(); print(it.next())`,
+			want: "1",
+		},
+		{
+			name: "nested function",
+			src:  `function counter() { var n = 0; function inc() { n = n + 1; return n } return inc } var c = counter(); c(); print(c())`,
+			want: "2",
+		},
+		{
+			name: "generator",
+			src:  `function mk() { var z = 5; function* g() { yield z } return g } print(mk()().next().value)`,
+			want: "5",
+		},
+		{
+			name: "class field",
+			src:  `function mk() { var v = 7; class B { h = v } return B } print(new (mk())().h)`,
+			want: "7",
+		},
+		{
+			name: "caller scope not visible",
+			src:  `function get() { return typeof x } function call() { var x = 1; return get() } print(call())`,
+			want: "undefined",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := run(t, tt.src); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMethodPrototype(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "object literal method",
+			src:  `var o = { m() {} }; print(o.m.prototype, "prototype" in o.m)`,
+			want: "undefined false",
+		},
+		{
+			name: "object literal generator method",
+			src:  `var o = { *g() {} }; print(typeof o.g.prototype)`,
+			want: "object",
+		},
+		{
+			name: "function",
+			src:  `function f() {} var o = { f: f }; print(typeof o.f.prototype, f.prototype.constructor === f)`,
+			want: "object true",
+		},
+		{
+			name: "class methods",
+			src:  `class A { m() {} static s() {} #p() {} p() { return this.#p } } print(A.prototype.m.prototype, A.s.prototype, new A().p().prototype)`,
+			want: "undefined undefined undefined",
+		},
+		{
+			name: "class constructor",
+			src:  `class A {} print(typeof A.prototype, A.prototype.constructor === A)`,
+			want: "object true",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := run(t, tt.src); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMethodConstructErrors(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{src: `var o = { m() {} }; new o.m()`, want: "is not a constructor"},
+		{src: `var o = { *g() {} }; new o.g()`, want: "is not a constructor"},
+		{src: `var o = { m() {} }; class B extends o.m {}`, want: "is not a constructor"},
+		{src: `class A { m() {} } new (new A().m)()`, want: "is not a constructor"},
+		{src: `class A { static s() {} } new A.s()`, want: "is not a constructor"},
+		{src: `class A { #p() {} static p() { return new A().#p } } new (A.p())()`, want: "is not a constructor"},
+	}
+
+	for _, tt := range tests {
+		if got := throws(t, tt.src); !strings.Contains(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.src, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package intp
+
+import (
+	"gojs/ast"
+	"gojs/lang"
+)
+
+// bindPattern initializes the targets of a pattern from a value. Declarations
+// create bindings in the current scope, while destructuring assignments put
+// the values into the references of their targets.
+// https://tc39.es/ecma262/#sec-runtime-semantics-bindinginitialization
+// https://tc39.es/ecma262/#sec-runtime-semantics-destructuringassignmentevaluation
+func (i *Interpreter) bindPattern(target ast.Pattern, value lang.Value, declare bool, k cont) step {
+	switch n := target.(type) {
+	case *ast.Identifier:
+		if declare {
+			i.initialize(n.Name, value)
+		} else {
+			i.putValue(reference{name: n.Name}, value)
+		}
+		return k(lang.NewUndefined())
+	case *ast.MemberExpression, *ast.ParenthesizedExpression:
+		return i.resolveReference(n, func(ref reference) step {
+			i.putValue(ref, value)
+			return k(lang.NewUndefined())
+		})
+	case *ast.AssignmentPattern:
+		return i.bindElement(n, func() lang.Value { return value }, declare, k)
+	case *ast.ObjectPattern:
+		return i.bindObjectPattern(n, value, declare, k)
+	case *ast.ArrayPattern:
+		return i.bindArrayPattern(n, value, declare, k)
+	default:
+		panic("invalid destructuring target")
+	}
+}
+
+// bindElement initializes a single element of a pattern from the value
+// produced by next. The reference of a simple assignment target is resolved
+// before the value is produced, and a default value is only evaluated when
+// the value is undefined.
+// https://tc39.es/ecma262/#sec-runtime-semantics-keyeddestructuringassignmentevaluation
+// https://tc39.es/ecma262/#sec-runtime-semantics-iteratordestructuringassignmentevaluation
+func (i *Interpreter) bindElement(element ast.Pattern, next func() lang.Value, declare bool, k cont) step {
+	target, initializer := element, ast.Expression(nil)
+	if n, ok := element.(*ast.AssignmentPattern); ok {
+		target, initializer = n.Left, n.Right
+	}
+
+	bind := func(ref *reference) step {
+		put := func(value lang.Value) step {
+			if ref != nil {
+				i.putValue(*ref, value)
+				return k(lang.NewUndefined())
+			}
+			return i.bindPattern(target, value, declare, k)
+		}
+
+		value := next()
+		if initializer != nil && value.Type == lang.ValueTypeUndefined {
+			return i.evaluateInitializer(initializer, target, put)
+		}
+		return put(value)
+	}
+
+	if !declare && isSimpleTarget(target) {
+		return i.resolveReference(target, func(ref reference) step {
+			return bind(&ref)
+		})
+	}
+	return bind(nil)
+}
+
+func isSimpleTarget(target ast.Pattern) bool {
+	switch target.(type) {
+	case *ast.Identifier, *ast.MemberExpression, *ast.ParenthesizedExpression:
+		return true
+	default:
+		return false
+	}
+}
+
+// evaluateInitializer evaluates the initializer of a target, which names an
+// anonymous function when the target is an identifier.
+func (i *Interpreter) evaluateInitializer(initializer ast.Expression, target ast.Pattern, k cont) step {
+	if id, ok := target.(*ast.Identifier); ok {
+		return i.namedEvaluation(initializer, id.Name, k)
+	}
+	return i.eval(initializer, k)
+}
+
+// https://tc39.es/ecma262/#sec-destructuring-binding-patterns-runtime-semantics-propertybindinginitialization
+// https://tc39.es/ecma262/#sec-runtime-semantics-restbindinginitialization
+func (i *Interpreter) bindObjectPattern(n *ast.ObjectPattern, value lang.Value, declare bool, k cont) step {
+	lang.RequireObjectCoercible(value)
+
+	var excluded []lang.PropertyKey
+	return each(len(n.Properties), func(idx int, next func() step) step {
+		then := func(lang.Value) step {
+			return next()
+		}
+
+		switch p := n.Properties[idx].(type) {
+		case *ast.Property:
+			return i.propertyKey(p.Key, p.Computed, func(key lang.PropertyKey) step {
+				excluded = append(excluded, key)
+				return i.bindElement(p.Value.(ast.Pattern), func() lang.Value {
+					return i.realm.GetV(value, key)
+				}, declare, then)
+			})
+		case *ast.RestElement:
+			return i.bindElement(p.Argument, func() lang.Value {
+				rest := i.realm.NewObject()
+				i.realm.CopyDataProperties(rest, value, excluded)
+				return lang.NewObj(rest)
+			}, declare, then)
+		}
+		return next()
+	}, func() step {
+		return k(lang.NewUndefined())
+	})
+}
+
+// The iterator is closed when the pattern does not exhaust it, including when
+// binding an element completes abruptly.
+// https://tc39.es/ecma262/#sec-runtime-semantics-iteratorbindinginitialization
+func (i *Interpreter) bindArrayPattern(n *ast.ArrayPattern, value lang.Value, declare bool, k cont) step {
+	ir := i.realm.GetIterator(value)
+	i.iterators = append(i.iterators, ir)
+
+	next := func() lang.Value {
+		if ir.Done {
+			return lang.NewUndefined()
+		}
+
+		value, _ := ir.StepValue()
+		return value
+	}
+
+	return each(len(n.Elements), func(idx int, more func() step) step {
+		then := func(lang.Value) step {
+			return more()
+		}
+
+		switch e := n.Elements[idx].(type) {
+		case nil:
+			next()
+			return more()
+		case *ast.RestElement:
+			return i.bindElement(e.Argument, func() lang.Value {
+				var values []lang.Value
+				for !ir.Done {
+					if value, ok := ir.StepValue(); ok {
+						values = append(values, value)
+					}
+				}
+				return lang.NewObj(i.realm.NewArray(values))
+			}, declare, then)
+		default:
+			return i.bindElement(e, next, declare, then)
+		}
+	}, func() step {
+		i.iterators = i.iterators[:len(i.iterators)-1]
+		if !ir.Done {
+			ir.Close()
+		}
+		return k(lang.NewUndefined())
+	})
+}
@@ -0,0 +1,112 @@
+package intp
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRegExp(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "sticky lastIndex",
+			src: `var r = /a/y; print(r.test("ba"), r.lastIndex)
+				r.lastIndex = 1; print(r.test("ba"), r.lastIndex, r.test("ba"), r.lastIndex)`,
+			want: "false 0\ntrue 2 false 0",
+		},
+		{
+			name: "global lastIndex",
+			src:  `var r = /o/g, s = "foo"; var a = r.exec(s).index, b = r.exec(s).index; print(a, b, r.exec(s), r.lastIndex)`,
+			want: "1 2 null 0",
+		},
+		{
+			name: "lastIndex past the end",
+			src:  `var r = /a/g; r.lastIndex = 5; print(r.test("aaa"), r.lastIndex)`,
+			want: "false 0",
+		},
+		{
+			name: "unicode lastIndex",
+			src:  `var r = /./gu; r.exec("😀x"); print(r.lastIndex, r.exec("😀x")[0])`,
+			want: "2 x",
+		},
+		{
+			name: "exec groups and indices",
+			src: `var m = /(?<x>b)(c)?/d.exec("abd")
+				print(m.index, m[1], m[2], m.groups.x, m.indices[1].join(), m.indices.groups.x.join())`,
+			want: "1 b undefined b 1,2 1,2",
+		},
+		{
+			name: "replace substitutions",
+			src:  "print(\"abc\".replace(/(?<x>b)/, \"[$<x>|$1|$&|$`|$'|$$|$2|$0]\"))",
+			want: "a[b|b|b|a|c|$|$2|$0]c",
+		},
+		{
+			name: "replace two digit groups",
+			src:  `print("abcdefghijk".replace(/(a)(b)(c)(d)(e)(f)(g)(h)(i)(j)(k)/, "$11-$10-$01-$100"))`,
+			want: "k-j-a-j0",
+		},
+		{
+			name: "replace global",
+			src:  `print("a-b-c".replace(/-/g, "+"), "aaa".replace(/a*?/g, "_"))`,
+			want: "a+b+c _a_a_a_",
+		},
+		{
+			name: "replace empty matches with unicode",
+			src:  `print("😀".replace(/(?:)/gu, "_").length, "😀".replace(/(?:)/g, "_").length)`,
+			want: "4 5",
+		},
+		{
+			name: "replace sticky",
+			src:  `print("aab".replace(/a/gy, "x"), "baa".replace(/a/gy, "x"))`,
+			want: "xxb baa",
+		},
+		{
+			name: "replace with a function",
+			src: `function f(match, p1, offset, string, groups) { return "<" + match + p1 + offset + groups.n + ">" }
+				print("xay".replace(/(?<n>a)/, f))`,
+			want: "x<aa1a>y",
+		},
+		{
+			name: "replace through Symbol.replace",
+			src: `var o = { [Symbol.replace](s, r) { return s + "/" + r } }
+				print("abc".replace(o, "x"), /b/[Symbol.replace]("abc", "$&$&"))`,
+			want: "abc/x abbc",
+		},
+		{
+			name: "replace with an own exec",
+			src: `var r = /a/g, calls = 0
+				function exec(s) { calls++; return calls > 2 ? null : { 0: "y", index: calls, length: 1 } }
+				r.exec = exec; print("xyz".replace(r, "-"), calls)`,
+			want: "x-- 3",
+		},
+		{
+			name: "split",
+			src:  `print("a1b22c".split(/(\d)+/).join("|"), "abc".split(/(?:)/).length)`,
+			want: "a|1|b|2|c 3",
+		},
+		{
+			name: "match and matchAll",
+			src: `var s = ""; for (var m of "a1b2".matchAll(/\d/g)) s += m[0] + m.index
+				print("a1b2".match(/\d/g).join(), s)`,
+			want: "1,2 1123",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := run(t, tt.src); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegExpStackOverflow(t *testing.T) {
+	src := `var s = "a"; for (var i = 0; i < 20; i++) s = s + s; /(a)*/.exec(s)`
+	if got := throws(t, src); !strings.Contains(got, "RangeError") {
+		t.Errorf("got %q, want a RangeError", got)
+	}
+}
@@ -0,0 +1,139 @@
+package lang
+
+import (
+	"strconv"
+)
+
+// ParameterBinding gives access to the binding of a formal parameter, which
+// a mapped arguments object aliases.
+type ParameterBinding struct {
+	Get func() Value
+	Set func(Value)
+}
+
+// ArgumentsObject is an arguments exotic object. Indices that appear in the
+// parameter map alias the bindings of the corresponding parameters; an
+// unmapped arguments object has no parameter map.
+// https://tc39.es/ecma262/#sec-arguments-exotic-objects
+type ArgumentsObject struct {
+	JsObject
+	parameterMap map[string]ParameterBinding
+}
+
+// https://tc39.es/ecma262/#sec-createunmappedargumentsobject
+func (r *Realm) CreateUnmappedArgumentsObject(args []Value) *ArgumentsObject {
+	o := r.newArgumentsObject(args)
+	o.JsObject.DefineOwnProperty("callee", NewAccessorDescriptor(r.ThrowTypeErrorFunction, r.ThrowTypeErrorFunction, false, false))
+	return o
+}
+
+// CreateMappedArgumentsObject creates the arguments object of a function
+// with simple parameters in sloppy mode. Later parameters win when a name
+// appears more than once.
+// https://tc39.es/ecma262/#sec-createmappedargumentsobject
+func (r *Realm) CreateMappedArgumentsObject(f Object, formals []string, args []Value, binding func(name string) ParameterBinding) *ArgumentsObject {
+	o := r.newArgumentsObject(args)
+	o.parameterMap = make(map[string]ParameterBinding)
+
+	mappedNames := make(map[string]bool)
+	for index := len(formals) - 1; index >= 0; index-- {
+		name := formals[index]
+		if mappedNames[name] {
+			continue
+		}
+
+		mappedNames[name] = true
+		if index < len(args) {
+			o.parameterMap[strconv.Itoa(index)] = binding(name)
+		}
+	}
+
+	o.JsObject.DefineOwnProperty("callee", NewDataDescriptor(NewObj(f), true, false, true))
+	return o
+}
+
+func (r *Realm) newArgumentsObject(args []Value) *ArgumentsObject {
+	o := &ArgumentsObject{}
+	o.init(r.ObjectPrototype)
+
+	for index, arg := range args {
+		CreateDataPropertyOrThrow(&o.JsObject, StrKey(strconv.Itoa(index)), arg)
+	}
+	o.JsObject.DefineOwnProperty("length", NewDataDescriptor(NewInt(len(args)), true, false, true))
+
+	values := r.ArrayPrototype.Get("values", NewObj(r.ArrayPrototype))
+	SymbolKey(SymbolIterator).DefineOwnProperty(&o.JsObject, NewDataDescriptor(values, true, false, true))
+	return o
+}
+
+// https://tc39.es/ecma262/#sec-arguments-exotic-objects-getownproperty-p
+func (a *ArgumentsObject) GetOwnProperty(name string) (PropertyDescriptor, bool) {
+	desc, ok := a.JsObject.GetOwnProperty(name)
+	if !ok {
+		return desc, false
+	}
+
+	if binding, isMapped := a.parameterMap[name]; isMapped {
+		desc.Value = binding.Get()
+	}
+	return desc, true
+}
+
+// https://tc39.es/ecma262/#sec-arguments-exotic-objects-defineownproperty-p-desc
+func (a *ArgumentsObject) DefineOwnProperty(name string, desc PropertyDescriptor) bool {
+	binding, isMapped := a.parameterMap[name]
+
+	newArgDesc := desc
+	if isMapped && desc.IsDataDescriptor() && !desc.HasValue && desc.HasWritable && !desc.Writable {
+		newArgDesc.Value, newArgDesc.HasValue = binding.Get(), true
+	}
+
+	if !a.JsObject.DefineOwnProperty(name, newArgDesc) {
+		return false
+	}
+
+	if isMapped {
+		if desc.IsAccessorDescriptor() {
+			delete(a.parameterMap, name)
+		} else {
+			if desc.HasValue {
+				binding.Set(desc.Value)
+			}
+			if desc.HasWritable && !desc.Writable {
+				delete(a.parameterMap, name)
+			}
+		}
+	}
+	return true
+}
+
+func (a *ArgumentsObject) HasProperty(name string) bool {
+	return OrdinaryHasProperty(a, name)
+}
+
+// https://tc39.es/ecma262/#sec-arguments-exotic-objects-get-p-receiver
+func (a *ArgumentsObject) Get(name string, receiver Value) Value {
+	if binding, isMapped := a.parameterMap[name]; isMapped {
+		return binding.Get()
+	}
+	return OrdinaryGet(a, name, receiver)
+}
+
+// https://tc39.es/ecma262/#sec-arguments-exotic-objects-set-p-v-receiver
+func (a *ArgumentsObject) Set(name string, value Value, receiver Value) bool {
+	if receiver.Type == ValueTypeObj && receiver.Obj == Object(a) {
+		if binding, isMapped := a.parameterMap[name]; isMapped {
+			binding.Set(value)
+		}
+	}
+	return OrdinarySet(a, name, value, receiver)
+}
+
+// https://tc39.es/ecma262/#sec-arguments-exotic-objects-delete-p
+func (a *ArgumentsObject) Delete(name string) bool {
+	result := a.JsObject.Delete(name)
+	if result {
+		delete(a.parameterMap, name)
+	}
+	return result
+}
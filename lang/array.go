@@ -0,0 +1,397 @@
+package lang
+
+import (
+	"strconv"
+)
+
+// Elements beyond this distance from the end of the dense store move the
+// array to the sparse representation instead of growing the store.
+const maxDenseGap = 1024
+
+// Array is an Array exotic object. Elements are kept in a dense slice, with
+// holes marked by an empty value, as long as every element is a plain
+// writable, enumerable and configurable data property. Otherwise, or when an
+// index far beyond the end is written, the elements are moved into the
+// ordinary property storage.
+// https://tc39.es/ecma262/#sec-array-exotic-objects
+type Array struct {
+	JsObject
+	dense          []Value
+	sparse         bool
+	length         uint32
+	lengthWritable bool
+}
+
+// https://tc39.es/ecma262/#sec-arraycreate
+func NewArray(prototype Object, values []Value) *Array {
+	if uint64(len(values)) > 1<<32-1 {
+		ThrowRangeError("Invalid array length")
+	}
+
+	a := &Array{
+		dense:          values,
+		length:         uint32(len(values)),
+		lengthWritable: true,
+	}
+	a.init(prototype)
+	return a
+}
+
+func (a *Array) Length() uint32 {
+	return a.length
+}
+
+func (a *Array) GetOwnProperty(name string) (PropertyDescriptor, bool) {
+	if name == "length" {
+		return NewDataDescriptor(NewInt(int(a.length)), a.lengthWritable, false, false), true
+	}
+
+	if idx, ok := IsArrayIndex(name); ok && !a.sparse {
+		if idx >= uint32(len(a.dense)) || a.dense[idx].Type == valueTypeEmpty {
+			return PropertyDescriptor{}, false
+		}
+
+		return NewDataDescriptor(a.dense[idx], true, true, true), true
+	}
+
+	return a.JsObject.GetOwnProperty(name)
+}
+
+// https://tc39.es/ecma262/#sec-array-exotic-objects-defineownproperty-p-desc
+func (a *Array) DefineOwnProperty(name string, desc PropertyDescriptor) bool {
+	if name == "length" {
+		return a.setLength(desc)
+	}
+
+	idx, ok := IsArrayIndex(name)
+	if !ok {
+		return a.JsObject.DefineOwnProperty(name, desc)
+	}
+
+	if idx >= a.length && !a.lengthWritable {
+		return false
+	}
+
+	if !a.sparse && !a.defineDenseElement(idx, desc) {
+		a.makeSparse()
+	}
+
+	if a.sparse && !a.JsObject.DefineOwnProperty(name, desc) {
+		return false
+	}
+
+	if idx >= a.length {
+		a.length = idx + 1
+	}
+	return true
+}
+
+func (a *Array) HasProperty(name string) bool {
+	return OrdinaryHasProperty(a, name)
+}
+
+func (a *Array) Get(name string, receiver Value) Value {
+	return OrdinaryGet(a, name, receiver)
+}
+
+func (a *Array) Set(name string, value Value, receiver Value) bool {
+	return OrdinarySet(a, name, value, receiver)
+}
+
+func (a *Array) Delete(name string) bool {
+	if name == "length" {
+		return false
+	}
+
+	if idx, ok := IsArrayIndex(name); ok && !a.sparse {
+		if idx < uint32(len(a.dense)) {
+			a.dense[idx] = Value{Type: valueTypeEmpty}
+		}
+		return true
+	}
+
+	return a.JsObject.Delete(name)
+}
+
+func (a *Array) OwnPropertyKeys() []string {
+	keys := make([]string, 0, len(a.dense)+len(a.keys)+1)
+	if !a.sparse {
+		for idx, v := range a.dense {
+			if v.Type != valueTypeEmpty {
+				keys = append(keys, strconv.Itoa(idx))
+			}
+		}
+	}
+
+	own := a.JsObject.OwnPropertyKeys()
+	n := 0
+	for n < len(own) {
+		if _, ok := IsArrayIndex(own[n]); !ok {
+			break
+		}
+		n++
+	}
+
+	keys = append(keys, own[:n]...)
+	keys = append(keys, "length")
+	return append(keys, own[n:]...)
+}
+
+// defineDenseElement stores the element in the dense store, reporting false
+// when the resulting property could not be represented there.
+func (a *Array) defineDenseElement(idx uint32, desc PropertyDescriptor) bool {
+	if desc.IsAccessorDescriptor() {
+		return false
+	}
+
+	exists := idx < uint32(len(a.dense)) && a.dense[idx].Type != valueTypeEmpty
+	for _, flag := range []struct{ has, value bool }{
+		{desc.HasWritable, desc.Writable},
+		{desc.HasEnumerable, desc.Enumerable},
+		{desc.HasConfigurable, desc.Configurable},
+	} {
+		if (flag.has && !flag.value) || (!flag.has && !exists) {
+			return false
+		}
+	}
+
+	if !exists && !a.extensible {
+		// Let the ordinary algorithm reject the new property.
+		return false
+	}
+
+	if idx >= uint32(len(a.dense)) {
+		if idx-uint32(len(a.dense)) > maxDenseGap && idx > 2*uint32(len(a.dense)) {
+			return false
+		}
+
+		for uint32(len(a.dense)) <= idx {
+			a.dense = append(a.dense, Value{Type: valueTypeEmpty})
+		}
+	}
+
+	if desc.HasValue {
+		a.dense[idx] = desc.Value
+	} else if !exists {
+		a.dense[idx] = NewUndefined()
+	}
+	return true
+}
+
+func (a *Array) makeSparse() {
+	keys := a.keys
+	a.keys = make([]string, 0, len(a.dense)+len(keys))
+	for idx, v := range a.dense {
+		if v.Type != valueTypeEmpty {
+			name := strconv.Itoa(idx)
+			a.keys = append(a.keys, name)
+			a.properties[name] = NewDataDescriptor(v, true, true, true)
+		}
+	}
+	a.keys = append(a.keys, keys...)
+	a.dense = nil
+	a.sparse = true
+}
+
+// https://tc39.es/ecma262/#sec-arraysetlength
+func (a *Array) setLength(desc PropertyDescriptor) bool {
+	if desc.IsAccessorDescriptor() ||
+		(desc.HasConfigurable && desc.Configurable) ||
+		(desc.HasEnumerable && desc.Enumerable) ||
+		(!a.lengthWritable && desc.HasWritable && desc.Writable) {
+		return false
+	}
+
+	if !desc.HasValue {
+		if desc.HasWritable && !desc.Writable {
+			a.lengthWritable = false
+		}
+		return true
+	}
+
+	newLen := toArrayLength(desc.Value)
+	if newLen == a.length {
+		if desc.HasWritable && !desc.Writable {
+			a.lengthWritable = false
+		}
+		return true
+	}
+
+	if !a.lengthWritable {
+		return false
+	}
+
+	if newLen > a.length {
+		a.length = newLen
+		if desc.HasWritable && !desc.Writable {
+			a.lengthWritable = false
+		}
+		return true
+	}
+
+	ok := true
+	if !a.sparse {
+		if newLen < uint32(len(a.dense)) {
+			a.dense = a.dense[:newLen]
+		}
+		a.length = newLen
+	} else {
+		a.length = a.truncateSparse(newLen)
+		ok = a.length == newLen
+	}
+
+	if desc.HasWritable && !desc.Writable {
+		a.lengthWritable = false
+	}
+	return ok
+}
+
+// truncateSparse deletes the elements at or above newLen in descending
+// order, stopping at the first element that cannot be deleted. It returns
+// the resulting length.
+func (a *Array) truncateSparse(newLen uint32) uint32 {
+	own := a.JsObject.OwnPropertyKeys()
+	for n := len(own) - 1; n >= 0; n-- {
+		idx, ok := IsArrayIndex(own[n])
+		if !ok || idx < newLen {
+			continue
+		}
+
+		if !a.JsObject.Delete(own[n]) {
+			return idx + 1
+		}
+	}
+	return newLen
+}
+
+func toArrayLength(v Value) uint32 {
+	newLen := ToUint32(v)
+	if float64(newLen) != ToNumber(v) {
+		ThrowRangeError("Invalid array length")
+	}
+
+	return newLen
+}
+
+// https://tc39.es/ecma262/#sec-array.prototype.join
+func arrayPrototypeJoin(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		o := r.ToObject(this)
+		length := ToLength(o.Get("length", NewObj(o)))
+
+		sep := ","
+		if len(args) > 0 && args[0].Type != ValueTypeUndefined {
+			sep = ToString(args[0])
+		}
+
+		var b concatBuilder
+		for k := int64(0); k < length; k++ {
+			if k > 0 {
+				b.WriteString(sep)
+			}
+
+			element := o.Get(strconv.FormatInt(k, 10), NewObj(o))
+			if element.Type != ValueTypeUndefined && element.Type != ValueTypeNull {
+				b.WriteString(ToString(element))
+			}
+		}
+		return NewStr(b.String())
+	}
+}
+
+// https://tc39.es/ecma262/#sec-array.prototype.tostring
+func arrayPrototypeToString(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		o := r.ToObject(this)
+		join := o.Get("join", NewObj(o))
+		if !IsCallable(join) {
+			return objectPrototypeToString(r)(NewObj(o), nil)
+		}
+
+		return Call(join, NewObj(o))
+	}
+}
+
+// https://tc39.es/ecma262/#sec-array.prototype.concat
+func arrayPrototypeConcat(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		o := r.ToObject(this)
+		a := r.ArraySpeciesCreate(o, 0)
+
+		n := int64(0)
+		for _, e := range append([]Value{NewObj(o)}, args...) {
+			if !IsConcatSpreadable(e) {
+				if n >= 1<<53-1 {
+					ThrowTypeError("Array length exceeds the maximum safe integer")
+				}
+				CreateDataPropertyOrThrow(a, StrKey(strconv.FormatInt(n, 10)), e)
+				n++
+				continue
+			}
+
+			length := LengthOfArrayLike(e.Obj)
+			if n+length > 1<<53-1 {
+				ThrowTypeError("Array length exceeds the maximum safe integer")
+			}
+			for k := int64(0); k < length; k++ {
+				p := strconv.FormatInt(k, 10)
+				if e.Obj.HasProperty(p) {
+					CreateDataPropertyOrThrow(a, StrKey(strconv.FormatInt(n, 10)), e.Obj.Get(p, e))
+				}
+				n++
+			}
+		}
+
+		Set(a, StrKey("length"), NewNumber(float64(n)), true)
+		return NewObj(a)
+	}
+}
+
+// https://tc39.es/ecma262/#sec-isconcatspreadable
+func IsConcatSpreadable(v Value) bool {
+	if v.Type != ValueTypeObj {
+		return false
+	}
+
+	if spreadable := SymbolKey(SymbolIsConcatSpreadable).Get(v.Obj, v); spreadable.Type != ValueTypeUndefined {
+		return ToBoolean(spreadable)
+	}
+
+	_, ok := v.Obj.(*Array)
+	return ok
+}
+
+// ArraySpeciesCreate creates an array, or an object of the species of the
+// original array when it is one.
+// https://tc39.es/ecma262/#sec-arrayspeciescreate
+func (r *Realm) ArraySpeciesCreate(original Object, length int64) Object {
+	c := NewUndefined()
+	if _, ok := original.(*Array); ok {
+		c = original.Get("constructor", NewObj(original))
+		if c.Type == ValueTypeObj {
+			c = SymbolKey(SymbolSpecies).Get(c.Obj, c)
+			if c.Type == ValueTypeNull {
+				c = NewUndefined()
+			}
+		}
+	}
+
+	if c.Type == ValueTypeUndefined {
+		a := r.NewArray(nil)
+		Set(a, StrKey("length"), NewNumber(float64(length)), true)
+		return a
+	}
+	if !IsConstructor(c) {
+		ThrowTypeError("object.constructor[Symbol.species] is not a constructor")
+	}
+	return Construct(c, []Value{NewNumber(float64(length))}, nil).Obj
+}
+
+// https://tc39.es/ecma262/#sec-array.prototype-@@unscopables
+func arrayUnscopables() *JsObject {
+	o := NewJsObject(nil)
+	for _, name := range []string{"at", "copyWithin", "entries", "fill", "find", "findIndex", "findLast", "findLastIndex", "flat", "flatMap", "includes", "keys", "toReversed", "toSorted", "toSpliced", "values"} {
+		CreateDataPropertyOrThrow(o, StrKey(name), NewBool(true))
+	}
+	return o
+}
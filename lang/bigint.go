@@ -0,0 +1,275 @@
+package lang
+
+import (
+	"math"
+	"math/big"
+	"strings"
+)
+
+// BigInt values larger than this many bits are not created by shifts, which
+// would otherwise exhaust memory.
+const maxBigIntBits = 1 << 30
+
+// https://tc39.es/ecma262/#sec-properties-of-bigint-instances
+type BigIntObject struct {
+	JsObject
+	BigIntData *big.Int
+}
+
+func (r *Realm) NewBigIntObject(n *big.Int) *BigIntObject {
+	o := &BigIntObject{BigIntData: n}
+	o.init(r.BigIntPrototype)
+	return o
+}
+
+func (r *Realm) initBigInt() {
+	r.BigIntPrototype = r.NewObject()
+
+	// https://tc39.es/ecma262/#sec-bigint-constructor-number-value
+	ctor := r.defineBuiltinConstructor("BigInt", 1, func(this Value, args []Value) Value {
+		prim := ToPrimitive(argument(args, 0), HintNumber)
+		if prim.Type == ValueTypeNumber {
+			return NewBigInt(NumberToBigInt(prim.Number))
+		}
+		return NewBigInt(ToBigInt(prim))
+	}, r.BigIntPrototype)
+	ctor.Constructor = func(args []Value, newTarget Object) Value {
+		ThrowTypeError("BigInt is not a constructor")
+		return NewUndefined()
+	}
+	r.defineBuiltinMethod(ctor, "asIntN", 2, bigIntAsIntN)
+	r.defineBuiltinMethod(ctor, "asUintN", 2, bigIntAsUintN)
+
+	p := r.BigIntPrototype
+	r.defineBuiltinMethod(p, "toString", 0, bigIntPrototypeToString)
+	r.defineBuiltinMethod(p, "toLocaleString", 0, bigIntPrototypeToString)
+	r.defineBuiltinMethod(p, "valueOf", 0, bigIntPrototypeValueOf)
+	r.defineToStringTag(p, "BigInt")
+}
+
+// https://tc39.es/ecma262/#sec-tobigint
+func ToBigInt(v Value) *big.Int {
+	prim := ToPrimitive(v, HintNumber)
+	switch prim.Type {
+	case ValueTypeBigInt:
+		return prim.BigInt
+	case ValueTypeBool:
+		if prim.Bool {
+			return big.NewInt(1)
+		}
+		return big.NewInt(0)
+	case ValueTypeStr:
+		n, ok := StringToBigInt(prim.Str)
+		if !ok {
+			ThrowSyntaxError("Cannot convert %s to a BigInt", prim.Str)
+		}
+		return n
+	case ValueTypeSymbol:
+		ThrowTypeError("Cannot convert a Symbol value to a BigInt")
+	default:
+		ThrowTypeError("Cannot convert %s to a BigInt", prim.String())
+	}
+	return nil
+}
+
+// https://tc39.es/ecma262/#sec-stringtobigint
+func StringToBigInt(str string) (*big.Int, bool) {
+	str = strings.TrimFunc(str, isStrWhiteSpaceChar)
+	if len(str) == 0 {
+		return big.NewInt(0), true
+	}
+
+	radix, digits := 10, str
+	if len(str) > 2 && str[0] == '0' {
+		switch str[1] {
+		case 'x', 'X':
+			radix = 16
+		case 'o', 'O':
+			radix = 8
+		case 'b', 'B':
+			radix = 2
+		}
+		if radix != 10 {
+			digits = str[2:]
+		}
+	}
+
+	if radix == 10 && (str[0] == '+' || str[0] == '-') {
+		digits = str[1:]
+	}
+	if len(digits) == 0 {
+		return nil, false
+	}
+	for _, ch := range digits {
+		if d := digitValue(ch); d < 0 || d >= radix {
+			return nil, false
+		}
+	}
+
+	n, _ := new(big.Int).SetString(digits, radix)
+	if str[0] == '-' {
+		n.Neg(n)
+	}
+	return n, true
+}
+
+// https://tc39.es/ecma262/#sec-numbertobigint
+func NumberToBigInt(number float64) *big.Int {
+	if math.IsNaN(number) || math.IsInf(number, 0) || number != math.Trunc(number) {
+		ThrowRangeError("The number %s cannot be converted to a BigInt because it is not an integer", NumberToString(number, 10))
+	}
+
+	n, _ := big.NewFloat(number).Int(nil)
+	return n
+}
+
+// compareBigIntNumber compares a BigInt with a Number that is not NaN,
+// returning -1, 0 or +1 as the BigInt is less than, equal to or greater than
+// the Number.
+func compareBigIntNumber(x *big.Int, y float64) int {
+	if math.IsInf(y, 1) {
+		return -1
+	}
+	if math.IsInf(y, -1) {
+		return 1
+	}
+	return new(big.Float).SetInt(x).Cmp(big.NewFloat(y))
+}
+
+// bigIntBinaryOperator applies an arithmetic, shift or bitwise operator to
+// two BigInts.
+// https://tc39.es/ecma262/#sec-numeric-types-bigint
+func bigIntBinaryOperator(x *big.Int, operator string, y *big.Int) *big.Int {
+	switch operator {
+	case "+":
+		return new(big.Int).Add(x, y)
+	case "-":
+		return new(big.Int).Sub(x, y)
+	case "*":
+		return new(big.Int).Mul(x, y)
+	case "/":
+		if y.Sign() == 0 {
+			ThrowRangeError("Division by zero")
+		}
+		return new(big.Int).Quo(x, y)
+	case "%":
+		if y.Sign() == 0 {
+			ThrowRangeError("Division by zero")
+		}
+		return new(big.Int).Rem(x, y)
+	case "**":
+		if y.Sign() < 0 {
+			ThrowRangeError("Exponent must be non-negative")
+		}
+		if x.BitLen() > 1 && (!y.IsInt64() || y.Int64()*int64(x.BitLen()) > maxBigIntBits) {
+			ThrowRangeError("Maximum BigInt size exceeded")
+		}
+		return new(big.Int).Exp(x, y, nil)
+	case "<<":
+		return bigIntLeftShift(x, y)
+	case ">>":
+		return bigIntLeftShift(x, new(big.Int).Neg(y))
+	case ">>>":
+		ThrowTypeError("BigInts have no unsigned right shift, use >> instead")
+	case "&":
+		return new(big.Int).And(x, y)
+	case "|":
+		return new(big.Int).Or(x, y)
+	case "^":
+		return new(big.Int).Xor(x, y)
+	}
+
+	panic("unsupported operator: " + operator)
+}
+
+// Shifting right rounds towards negative infinity, as for two's complement
+// integers.
+// https://tc39.es/ecma262/#sec-numeric-types-bigint-leftShift
+func bigIntLeftShift(x, y *big.Int) *big.Int {
+	if y.Sign() >= 0 {
+		if x.Sign() == 0 {
+			return big.NewInt(0)
+		}
+		if !y.IsInt64() || y.Int64()+int64(x.BitLen()) > maxBigIntBits {
+			ThrowRangeError("Maximum BigInt size exceeded")
+		}
+		return new(big.Int).Lsh(x, uint(y.Int64()))
+	}
+
+	shift := new(big.Int).Neg(y)
+	if !shift.IsInt64() || shift.Int64() > int64(x.BitLen()) {
+		if x.Sign() < 0 {
+			return big.NewInt(-1)
+		}
+		return big.NewInt(0)
+	}
+	return new(big.Int).Rsh(x, uint(shift.Int64()))
+}
+
+// https://tc39.es/ecma262/#sec-bigint.asintn
+func bigIntAsIntN(this Value, args []Value) Value {
+	bits := toBigIntBits(argument(args, 0))
+	mod := bigIntModPow2(ToBigInt(argument(args, 1)), bits)
+	if bits > 0 && mod.Bit(int(bits-1)) == 1 {
+		mod.Sub(mod, new(big.Int).Lsh(big.NewInt(1), bits))
+	}
+	return NewBigInt(mod)
+}
+
+// https://tc39.es/ecma262/#sec-bigint.asuintn
+func bigIntAsUintN(this Value, args []Value) Value {
+	bits := toBigIntBits(argument(args, 0))
+	return NewBigInt(bigIntModPow2(ToBigInt(argument(args, 1)), bits))
+}
+
+// toBigIntBits converts the bit count of BigInt.asIntN and BigInt.asUintN.
+// https://tc39.es/ecma262/#sec-toindex
+func toBigIntBits(v Value) uint {
+	bits := ToIntegerOrInfinity(v)
+	if bits < 0 || bits > 1<<53-1 {
+		ThrowRangeError("Invalid value: not (convertible to) a safe integer")
+	}
+	if bits > maxBigIntBits {
+		ThrowRangeError("Maximum BigInt size exceeded")
+	}
+	return uint(bits)
+}
+
+// bigIntModPow2 returns the non-negative remainder of n divided by 2^bits.
+func bigIntModPow2(n *big.Int, bits uint) *big.Int {
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), bits), big.NewInt(1))
+	return mask.And(n, mask)
+}
+
+// https://tc39.es/ecma262/#sec-thisbigintvalue
+func thisBigIntValue(this Value, method string) *big.Int {
+	if this.Type == ValueTypeBigInt {
+		return this.BigInt
+	}
+	if o, ok := this.Obj.(*BigIntObject); ok && this.Type == ValueTypeObj {
+		return o.BigIntData
+	}
+	ThrowTypeError("BigInt.prototype.%s requires that 'this' be a BigInt", method)
+	return nil
+}
+
+// https://tc39.es/ecma262/#sec-bigint.prototype.tostring
+func bigIntPrototypeToString(this Value, args []Value) Value {
+	x := thisBigIntValue(this, "toString")
+
+	radix := 10.0
+	if len(args) > 0 && args[0].Type != ValueTypeUndefined {
+		radix = ToIntegerOrInfinity(args[0])
+	}
+
+	if radix < 2 || radix > 36 {
+		ThrowRangeError("toString() radix must be between 2 and 36")
+	}
+
+	return NewStr(x.Text(int(radix)))
+}
+
+// https://tc39.es/ecma262/#sec-bigint.prototype.valueof
+func bigIntPrototypeValueOf(this Value, args []Value) Value {
+	return NewBigInt(thisBigIntValue(this, "valueOf"))
+}
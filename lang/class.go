@@ -0,0 +1,139 @@
+package lang
+
+import "gojs/ast"
+
+// ConstructorKind tells whether a constructor allocates its this value or
+// leaves it to the constructor of its parent class.
+type ConstructorKind int
+
+const (
+	ConstructorKindBase ConstructorKind = iota
+	ConstructorKindDerived
+)
+
+// PrivateName is the unique key of a private class element. Every evaluation
+// of a class creates new private names, even for the same source text.
+// https://tc39.es/ecma262/#sec-private-names
+type PrivateName struct {
+	Description string
+}
+
+// PrivateEnvironment holds the private names of a class body.
+// https://tc39.es/ecma262/#sec-privateenvironment-records
+type PrivateEnvironment struct {
+	Outer *PrivateEnvironment
+	Names map[string]*PrivateName
+}
+
+// https://tc39.es/ecma262/#sec-newprivateenvironment
+func NewPrivateEnvironment(outer *PrivateEnvironment) *PrivateEnvironment {
+	return &PrivateEnvironment{Outer: outer, Names: make(map[string]*PrivateName)}
+}
+
+// Resolve returns the private name an identifier refers to. The parser
+// rejects identifiers that are not declared by an enclosing class.
+// https://tc39.es/ecma262/#sec-resolve-private-identifier
+func (e *PrivateEnvironment) Resolve(identifier string) *PrivateName {
+	for env := e; env != nil; env = env.Outer {
+		if name, ok := env.Names[identifier]; ok {
+			return name
+		}
+	}
+	panic("unresolvable private name: #" + identifier)
+}
+
+type PrivateElementKind int
+
+const (
+	PrivateElementKindField PrivateElementKind = iota
+	PrivateElementKindMethod
+	PrivateElementKindAccessor
+)
+
+// https://tc39.es/ecma262/#sec-privateelement-specification-type
+type PrivateElement struct {
+	Key      *PrivateName
+	Kind     PrivateElementKind
+	Value    Value
+	Get, Set Object
+}
+
+// ClassFieldDefinition is a field the constructor of a class defines on its
+// instances, or that a class defines on itself when the field is static.
+// PrivateName is set for private fields, and Name otherwise.
+// https://tc39.es/ecma262/#sec-classfielddefinition-record-specification-type
+type ClassFieldDefinition struct {
+	Name        PropertyKey
+	PrivateName *PrivateName
+	Initializer ast.Expression
+}
+
+// https://tc39.es/ecma262/#sec-privateelementfind
+func PrivateElementFind(o Object, p *PrivateName) *PrivateElement {
+	for _, element := range ordinaryObject(o).privateElements {
+		if element.Key == p {
+			return element
+		}
+	}
+	return nil
+}
+
+// https://tc39.es/ecma262/#sec-privatefieldadd
+func PrivateFieldAdd(o Object, p *PrivateName, value Value) {
+	if PrivateElementFind(o, p) != nil {
+		ThrowTypeError("Cannot initialize %s twice on the same object", p.Description)
+	}
+
+	j := ordinaryObject(o)
+	j.privateElements = append(j.privateElements, &PrivateElement{Key: p, Kind: PrivateElementKindField, Value: value})
+}
+
+// https://tc39.es/ecma262/#sec-privatemethodoraccessoradd
+func PrivateMethodOrAccessorAdd(o Object, method *PrivateElement) {
+	if PrivateElementFind(o, method.Key) != nil {
+		ThrowTypeError("Cannot initialize %s twice on the same object", method.Key.Description)
+	}
+
+	j := ordinaryObject(o)
+	j.privateElements = append(j.privateElements, method)
+}
+
+// https://tc39.es/ecma262/#sec-privateget
+func PrivateGet(o Object, p *PrivateName) Value {
+	entry := PrivateElementFind(o, p)
+	if entry == nil {
+		ThrowTypeError("Cannot read private member %s from an object whose class did not declare it", p.Description)
+	}
+
+	if entry.Kind != PrivateElementKindAccessor {
+		return entry.Value
+	}
+	if entry.Get == nil {
+		ThrowTypeError("'%s' was defined without a getter", p.Description)
+	}
+	return Call(NewObj(entry.Get), NewObj(o))
+}
+
+// https://tc39.es/ecma262/#sec-privateset
+func PrivateSet(o Object, p *PrivateName, value Value) {
+	entry := PrivateElementFind(o, p)
+	if entry == nil {
+		ThrowTypeError("Cannot write private member %s to an object whose class did not declare it", p.Description)
+	}
+
+	switch entry.Kind {
+	case PrivateElementKindField:
+		entry.Value = value
+	case PrivateElementKindMethod:
+		ThrowTypeError("Private method '%s' is not writable", p.Description)
+	case PrivateElementKindAccessor:
+		if entry.Set == nil {
+			ThrowTypeError("'%s' was defined without a setter", p.Description)
+		}
+		Call(NewObj(entry.Set), NewObj(o), value)
+	}
+}
+
+func ordinaryObject(o Object) *JsObject {
+	return o.(interface{ ordinary() *JsObject }).ordinary()
+}
@@ -0,0 +1,323 @@
+package lang
+
+import (
+	"math"
+)
+
+type Hint int
+
+const (
+	HintDefault Hint = iota
+	HintNumber
+	HintString
+)
+
+// https://tc39.es/ecma262/#sec-iscallable
+func IsCallable(v Value) bool {
+	if v.Type != ValueTypeObj {
+		return false
+	}
+
+	_, ok := v.Obj.(Callable)
+	return ok
+}
+
+// https://tc39.es/ecma262/#sec-call
+func Call(f Value, this Value, args ...Value) Value {
+	if !IsCallable(f) {
+		ThrowTypeError("%s is not a function", f.String())
+	}
+
+	return f.Obj.(Callable).Call(this, args)
+}
+
+// https://tc39.es/ecma262/#sec-isconstructor
+func IsConstructor(v Value) bool {
+	if v.Type != ValueTypeObj {
+		return false
+	}
+
+	// Built-in functions only construct when they are given a constructor.
+	if f, ok := v.Obj.(*NativeFunction); ok {
+		return f.Constructor != nil
+	}
+
+	// Generator functions and methods are never constructors.
+	if f, ok := v.Obj.(*Function); ok {
+		return !f.Generator && f.Kind != FunctionKindMethod
+	}
+
+	_, ok := v.Obj.(Constructor)
+	return ok
+}
+
+// Construct creates an object with the constructor f. The new target
+// defaults to f itself.
+// https://tc39.es/ecma262/#sec-construct
+func Construct(f Value, args []Value, newTarget Object) Value {
+	if !IsConstructor(f) {
+		ThrowTypeError("%s is not a constructor", f.String())
+	}
+
+	if newTarget == nil {
+		newTarget = f.Obj
+	}
+	return f.Obj.(Constructor).Construct(args, newTarget)
+}
+
+// https://tc39.es/ecma262/#sec-toprimitive
+func ToPrimitive(input Value, hint Hint) Value {
+	if input.Type != ValueTypeObj {
+		return input
+	}
+
+	exoticToPrim := SymbolKey(SymbolToPrimitive).Get(input.Obj, input)
+	if exoticToPrim.Type != ValueTypeUndefined && exoticToPrim.Type != ValueTypeNull {
+		if !IsCallable(exoticToPrim) {
+			ThrowTypeError("Symbol.toPrimitive is not a function")
+		}
+
+		hintName := "default"
+		switch hint {
+		case HintNumber:
+			hintName = "number"
+		case HintString:
+			hintName = "string"
+		}
+
+		result := Call(exoticToPrim, input, NewStr(hintName))
+		if result.Type == ValueTypeObj {
+			ThrowTypeError("Cannot convert object to primitive value")
+		}
+		return result
+	}
+
+	if hint == HintDefault {
+		hint = HintNumber
+	}
+	return OrdinaryToPrimitive(input.Obj, hint)
+}
+
+// https://tc39.es/ecma262/#sec-ordinarytoprimitive
+func OrdinaryToPrimitive(o Object, hint Hint) Value {
+	methodNames := []string{"valueOf", "toString"}
+	if hint == HintString {
+		methodNames = []string{"toString", "valueOf"}
+	}
+
+	for _, name := range methodNames {
+		method := o.Get(name, NewObj(o))
+		if IsCallable(method) {
+			result := Call(method, NewObj(o))
+			if result.Type != ValueTypeObj {
+				return result
+			}
+		}
+	}
+
+	ThrowTypeError("Cannot convert object to primitive value")
+	return NewUndefined()
+}
+
+// https://tc39.es/ecma262/#sec-toboolean
+func ToBoolean(v Value) bool {
+	switch v.Type {
+	case ValueTypeBool:
+		return v.Bool
+	case ValueTypeNumber:
+		return v.Number != 0 && !math.IsNaN(v.Number)
+	case ValueTypeStr:
+		return len(v.Str) > 0
+	case ValueTypeBigInt:
+		return v.BigInt.Sign() != 0
+	case ValueTypeObj, ValueTypeSymbol:
+		return true
+	default:
+		return false
+	}
+}
+
+// https://tc39.es/ecma262/#sec-tonumeric
+func ToNumeric(v Value) Value {
+	prim := ToPrimitive(v, HintNumber)
+	if prim.Type == ValueTypeBigInt {
+		return prim
+	}
+	return NewNumber(ToNumber(prim))
+}
+
+// https://tc39.es/ecma262/#sec-tonumber
+func ToNumber(v Value) float64 {
+	switch v.Type {
+	case ValueTypeNumber:
+		return v.Number
+	case ValueTypeUndefined:
+		return math.NaN()
+	case ValueTypeNull:
+		return 0
+	case ValueTypeBool:
+		if v.Bool {
+			return 1
+		}
+		return 0
+	case ValueTypeStr:
+		return StringToNumber(v.Str)
+	case ValueTypeSymbol:
+		ThrowTypeError("Cannot convert a Symbol value to a number")
+		return 0
+	case ValueTypeBigInt:
+		ThrowTypeError("Cannot convert a BigInt value to a number")
+		return 0
+	default:
+		return ToNumber(ToPrimitive(v, HintNumber))
+	}
+}
+
+// https://tc39.es/ecma262/#sec-tointegerorinfinity
+func ToIntegerOrInfinity(v Value) float64 {
+	number := ToNumber(v)
+	if math.IsNaN(number) || number == 0 {
+		return 0
+	}
+
+	return math.Trunc(number)
+}
+
+// https://tc39.es/ecma262/#sec-tolength
+func ToLength(v Value) int64 {
+	length := ToIntegerOrInfinity(v)
+	if length <= 0 {
+		return 0
+	}
+
+	return int64(math.Min(length, 1<<53-1))
+}
+
+// https://tc39.es/ecma262/#sec-toint32
+func ToInt32(v Value) int32 {
+	return int32(ToUint32(v))
+}
+
+// https://tc39.es/ecma262/#sec-touint32
+func ToUint32(v Value) uint32 {
+	number := ToNumber(v)
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return 0
+	}
+
+	int32bit := math.Mod(math.Trunc(number), 1<<32)
+	if int32bit < 0 {
+		int32bit += 1 << 32
+	}
+	return uint32(int32bit)
+}
+
+// https://tc39.es/ecma262/#sec-tostring
+func ToString(v Value) string {
+	switch v.Type {
+	case ValueTypeStr:
+		return v.Str
+	case ValueTypeUndefined:
+		return "undefined"
+	case ValueTypeNull:
+		return "null"
+	case ValueTypeBool:
+		if v.Bool {
+			return "true"
+		}
+		return "false"
+	case ValueTypeNumber:
+		return NumberToString(v.Number, 10)
+	case ValueTypeBigInt:
+		return v.BigInt.String()
+	case ValueTypeSymbol:
+		ThrowTypeError("Cannot convert a Symbol value to a string")
+		return ""
+	default:
+		return ToString(ToPrimitive(v, HintString))
+	}
+}
+
+// https://tc39.es/ecma262/#sec-topropertykey
+func ToPropertyKey(v Value) PropertyKey {
+	key := ToPrimitive(v, HintString)
+	if key.Type == ValueTypeSymbol {
+		return SymbolKey(key.Symbol)
+	}
+	return StrKey(ToString(key))
+}
+
+// https://tc39.es/ecma262/#sec-toobject
+func (r *Realm) ToObject(v Value) Object {
+	switch v.Type {
+	case ValueTypeObj:
+		return v.Obj
+	case ValueTypeBool:
+		return r.NewBooleanObject(v.Bool)
+	case ValueTypeNumber:
+		return r.NewNumberObject(v.Number)
+	case ValueTypeStr:
+		return r.newStringObject(v)
+	case ValueTypeSymbol:
+		return r.NewSymbolObject(v.Symbol)
+	case ValueTypeBigInt:
+		return r.NewBigIntObject(v.BigInt)
+	default:
+		ThrowTypeError("Cannot convert %s to object", v.String())
+		return nil
+	}
+}
+
+// https://tc39.es/ecma262/#sec-requireobjectcoercible
+func RequireObjectCoercible(v Value) Value {
+	if v.Type == ValueTypeUndefined || v.Type == ValueTypeNull {
+		ThrowTypeError("Cannot convert %s to object", v.String())
+	}
+	return v
+}
+
+// https://tc39.es/ecma262/#sec-islessthan
+func IsLessThan(x, y Value, leftFirst bool) (result bool, undefined bool) {
+	var px, py Value
+	if leftFirst {
+		px = ToPrimitive(x, HintNumber)
+		py = ToPrimitive(y, HintNumber)
+	} else {
+		py = ToPrimitive(y, HintNumber)
+		px = ToPrimitive(x, HintNumber)
+	}
+
+	if px.Type == ValueTypeStr && py.Type == ValueTypeStr {
+		return compareStrings(px.Str, py.Str) < 0, false
+	}
+
+	if px.Type == ValueTypeBigInt && py.Type == ValueTypeStr {
+		ny, ok := StringToBigInt(py.Str)
+		if !ok {
+			return false, true
+		}
+		return px.BigInt.Cmp(ny) < 0, false
+	}
+
+	if px.Type == ValueTypeStr && py.Type == ValueTypeBigInt {
+		nx, ok := StringToBigInt(px.Str)
+		if !ok {
+			return false, true
+		}
+		return nx.Cmp(py.BigInt) < 0, false
+	}
+
+	nx, ny := ToNumeric(px), ToNumeric(py)
+	switch {
+	case nx.Type == ValueTypeBigInt && ny.Type == ValueTypeBigInt:
+		return nx.BigInt.Cmp(ny.BigInt) < 0, false
+	case math.IsNaN(nx.Number) || math.IsNaN(ny.Number):
+		return false, true
+	case nx.Type == ValueTypeBigInt:
+		return compareBigIntNumber(nx.BigInt, ny.Number) < 0, false
+	case ny.Type == ValueTypeBigInt:
+		return compareBigIntNumber(ny.BigInt, nx.Number) > 0, false
+	}
+
+	return nx.Number < ny.Number, false
+}
@@ -0,0 +1,73 @@
+package lang
+
+import "fmt"
+
+type ErrorKind int
+
+const (
+	ErrorKindError ErrorKind = iota
+	ErrorKindRangeError
+	ErrorKindReferenceError
+	ErrorKindSyntaxError
+	ErrorKindTypeError
+)
+
+func (ek ErrorKind) String() string {
+	switch ek {
+	case ErrorKindError:
+		return "Error"
+	case ErrorKindRangeError:
+		return "RangeError"
+	case ErrorKindReferenceError:
+		return "ReferenceError"
+	case ErrorKindSyntaxError:
+		return "SyntaxError"
+	case ErrorKindTypeError:
+		return "TypeError"
+	default:
+		return "Unknown"
+	}
+}
+
+// Exception is the panic value used to unwind a thrown ECMAScript error
+// through the Go call stack.
+type Exception struct {
+	Kind    ErrorKind
+	Message string
+
+	// Value is the thrown value when it is not an error created by the
+	// engine.
+	Value *Value
+}
+
+func (e *Exception) Error() string {
+	if e.Value != nil {
+		return "Uncaught " + e.Value.String()
+	}
+	return e.Kind.String() + ": " + e.Message
+}
+
+func throw(kind ErrorKind, format string, args ...interface{}) {
+	panic(&Exception{Kind: kind, Message: fmt.Sprintf(format, args...)})
+}
+
+// ThrowValue throws an arbitrary value.
+func ThrowValue(v Value) {
+	panic(&Exception{Value: &v})
+}
+
+func ThrowRangeError(format string, args ...interface{}) {
+	throw(ErrorKindRangeError, format, args...)
+}
+
+func ThrowReferenceError(format string, args ...interface{}) {
+	throw(ErrorKindReferenceError, format, args...)
+}
+
+func ThrowSyntaxError(format string, args ...interface{}) {
+	throw(ErrorKindSyntaxError, format, args...)
+}
+
+func ThrowTypeError(format string, args ...interface{}) {
+	throw(ErrorKindTypeError, format, args...)
+}
@@ -0,0 +1,183 @@
+package lang
+
+import "gojs/ast"
+
+// Evaluator evaluates the body of script functions on behalf of [[Call]] and
+// [[Construct]].
+type Evaluator interface {
+	CallFunction(f *Function, this Value, args []Value) Value
+	ConstructFunction(f *Function, args []Value, newTarget Object) Value
+}
+
+// Function is an ECMAScript function object backed by script source.
+// https://tc39.es/ecma262/#sec-ecmascript-function-objects
+type Function struct {
+	JsObject
+	Realm      *Realm
+	Name       string
+	Body       ast.Statement
+	Parameters []ast.Pattern
+	SourceText string
+
+	// Strict is set when the function code is strict mode code.
+	Strict bool
+
+	// Kind tells whether the function is a method, which is not a
+	// constructor.
+	Kind FunctionKind
+
+	// Generator is set for generator functions and methods, whose calls
+	// return a generator that evaluates the body.
+	Generator bool
+
+	// HomeObject is the object whose prototype super property references
+	// in a method are looked up on.
+	HomeObject Object
+
+	// Environment holds the bindings of the code the function was created
+	// in, which its body is evaluated with. Only the Evaluator knows what it
+	// holds.
+	Environment interface{}
+
+	// PrivateEnvironment holds the private names of the classes enclosing
+	// the function.
+	PrivateEnvironment *PrivateEnvironment
+
+	// The constructor of a class cannot be called without new. A body of nil
+	// marks a default constructor, which derived classes call the parent
+	// constructor from.
+	// https://tc39.es/ecma262/#sec-ecmascript-function-objects
+	ConstructorKind    ConstructorKind
+	IsClassConstructor bool
+	Fields             []ClassFieldDefinition
+	PrivateMethods     []*PrivateElement
+}
+
+// FunctionKind tells a function declaration or expression from a method,
+// getter or setter, which is not a constructor and has no prototype property.
+// https://tc39.es/ecma262/#sec-definemethod
+type FunctionKind int
+
+const (
+	FunctionKindNormal FunctionKind = iota
+	FunctionKindMethod
+)
+
+// https://tc39.es/ecma262/#sec-ordinaryfunctioncreate
+func (r *Realm) NewFunction(kind FunctionKind, name string, body ast.Statement, parameters []ast.Pattern, sourceText string) *Function {
+	f := &Function{
+		Realm:      r,
+		Kind:       kind,
+		Name:       name,
+		Body:       body,
+		Parameters: parameters,
+		SourceText: sourceText,
+	}
+	f.init(r.FunctionPrototype)
+
+	f.DefineOwnProperty("length", NewDataDescriptor(NewInt(ast.ExpectedArgumentCount(parameters)), false, false, true))
+	f.DefineOwnProperty("name", NewDataDescriptor(NewStr(name), false, false, true))
+	if kind == FunctionKindMethod {
+		return f
+	}
+
+	// https://tc39.es/ecma262/#sec-makeconstructor
+	prototype := r.NewObject()
+	prototype.DefineOwnProperty("constructor", NewDataDescriptor(NewObj(f), true, false, true))
+	f.DefineOwnProperty("prototype", NewDataDescriptor(NewObj(prototype), true, false, false))
+	return f
+}
+
+// The prototype of a generator function is the prototype of the generators it
+// returns, so it has no constructor property.
+// https://tc39.es/ecma262/#sec-runtime-semantics-instantiategeneratorfunctionobject
+func (r *Realm) NewGeneratorFunction(kind FunctionKind, name string, body ast.Statement, parameters []ast.Pattern, sourceText string) *Function {
+	f := r.NewFunction(kind, name, body, parameters, sourceText)
+	f.Generator = true
+	f.SetPrototypeOf(r.GeneratorFunctionPrototype)
+
+	prototype := NewJsObject(r.GeneratorPrototype)
+	f.DefineOwnProperty("prototype", NewDataDescriptor(NewObj(prototype), true, false, false))
+	return f
+}
+
+func (f *Function) Call(this Value, args []Value) Value {
+	return f.Realm.Evaluator.CallFunction(f, this, args)
+}
+
+// https://tc39.es/ecma262/#sec-ecmascript-function-objects-construct-argumentslist-newtarget
+func (f *Function) Construct(args []Value, newTarget Object) Value {
+	return f.Realm.Evaluator.ConstructFunction(f, args, newTarget)
+}
+
+// GetPrototypeFromConstructor returns the prototype property of the
+// constructor, falling back to the intrinsic default when it is not an object.
+// https://tc39.es/ecma262/#sec-getprototypefromconstructor
+func GetPrototypeFromConstructor(constructor Object, intrinsicDefault Object) Object {
+	proto := constructor.Get("prototype", NewObj(constructor))
+	if proto.Type != ValueTypeObj {
+		return intrinsicDefault
+	}
+	return proto.Obj
+}
+
+// NativeFunction is a built-in function object implemented in Go.
+// https://tc39.es/ecma262/#sec-built-in-function-objects
+type NativeFunction struct {
+	JsObject
+	Name     string
+	Function func(this Value, args []Value) Value
+
+	// Constructor implements [[Construct]], or is nil when the function is
+	// not a constructor.
+	Constructor func(args []Value, newTarget Object) Value
+}
+
+// https://tc39.es/ecma262/#sec-createbuiltinfunction
+func (r *Realm) NewNativeFunction(name string, length int, function func(this Value, args []Value) Value) *NativeFunction {
+	f := &NativeFunction{Name: name, Function: function}
+	f.init(r.FunctionPrototype)
+
+	f.DefineOwnProperty("length", NewDataDescriptor(NewInt(length), false, false, true))
+	f.DefineOwnProperty("name", NewDataDescriptor(NewStr(name), false, false, true))
+	return f
+}
+
+func (f *NativeFunction) Call(this Value, args []Value) Value {
+	return f.Function(this, args)
+}
+
+func (f *NativeFunction) Construct(args []Value, newTarget Object) Value {
+	if f.Constructor == nil {
+		ThrowTypeError("%s is not a constructor", f.Name)
+	}
+	return f.Constructor(args, newTarget)
+}
+
+// https://tc39.es/ecma262/#sec-function.prototype.tostring
+func functionPrototypeToString(this Value, args []Value) Value {
+	if this.Type == ValueTypeObj {
+		switch f := this.Obj.(type) {
+		case *Function:
+			if f.SourceText != "" {
+				return NewStr(f.SourceText)
+			}
+			return NewStr(nativeFunctionSource(f.Name))
+		case *NativeFunction:
+			return NewStr(nativeFunctionSource(f.Name))
+		}
+	}
+
+	ThrowTypeError("Function.prototype.toString requires that 'this' be a Function")
+	return NewUndefined()
+}
+
+// https://tc39.es/ecma262/#prod-NativeFunction
+func nativeFunctionSource(name string) string {
+	return "function " + name + "() { [native code] }"
+}
+
+// https://tc39.es/ecma262/#sec-function.prototype-@@hasinstance
+func functionPrototypeHasInstance(this Value, args []Value) Value {
+	return NewBool(OrdinaryHasInstance(this, argument(args, 0)))
+}
@@ -0,0 +1,110 @@
+package lang
+
+// https://tc39.es/ecma262/#sec-properties-of-generator-instances
+type GeneratorState int
+
+const (
+	GeneratorStateSuspendedStart GeneratorState = iota
+	GeneratorStateSuspendedYield
+	GeneratorStateExecuting
+	GeneratorStateCompleted
+)
+
+// CompletionType is how a suspended generator is resumed: with a value for
+// the yield, or as if a return or throw statement were in its place.
+// https://tc39.es/ecma262/#sec-completion-record-specification-type
+type CompletionType int
+
+const (
+	CompletionTypeNormal CompletionType = iota
+	CompletionTypeReturn
+	CompletionTypeThrow
+)
+
+// GeneratorObject is a generator instance. Resume evaluates the body until it
+// yields or completes, returning the iterator result and whether the body
+// completed.
+// https://tc39.es/ecma262/#sec-generator-objects
+type GeneratorObject struct {
+	JsObject
+	State  GeneratorState
+	Resume func(completion CompletionType, value Value) (Value, bool)
+}
+
+// https://tc39.es/ecma262/#sec-ordinarycreatefromconstructor
+func (r *Realm) NewGeneratorObject(f *Function) *GeneratorObject {
+	g := &GeneratorObject{}
+	g.init(GetPrototypeFromConstructor(f, r.GeneratorPrototype))
+	return g
+}
+
+func (r *Realm) initGenerator() {
+	r.GeneratorFunctionPrototype = NewJsObject(r.FunctionPrototype)
+	r.GeneratorPrototype = NewJsObject(r.IteratorPrototype)
+
+	r.GeneratorFunctionPrototype.DefineOwnProperty("prototype", NewDataDescriptor(NewObj(r.GeneratorPrototype), false, false, true))
+	r.GeneratorPrototype.DefineOwnProperty("constructor", NewDataDescriptor(NewObj(r.GeneratorFunctionPrototype), false, false, true))
+
+	r.defineBuiltinMethod(r.GeneratorPrototype, "next", 1, generatorPrototypeResume(r, CompletionTypeNormal, "next"))
+	r.defineBuiltinMethod(r.GeneratorPrototype, "return", 1, generatorPrototypeResume(r, CompletionTypeReturn, "return"))
+	r.defineBuiltinMethod(r.GeneratorPrototype, "throw", 1, generatorPrototypeResume(r, CompletionTypeThrow, "throw"))
+	r.defineToStringTag(r.GeneratorFunctionPrototype, "GeneratorFunction")
+	r.defineToStringTag(r.GeneratorPrototype, "Generator")
+}
+
+// A generator that has not started completes without evaluating its body
+// when it is returned from or thrown into, and a completed generator only
+// reflects the completion back.
+// https://tc39.es/ecma262/#sec-generator.prototype.next
+// https://tc39.es/ecma262/#sec-generator.prototype.return
+// https://tc39.es/ecma262/#sec-generator.prototype.throw
+// https://tc39.es/ecma262/#sec-generatorresumeabrupt
+func generatorPrototypeResume(r *Realm, completion CompletionType, method string) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		g := thisGeneratorObject(this, method)
+		value := argument(args, 0)
+
+		switch g.State {
+		case GeneratorStateExecuting:
+			ThrowTypeError("Generator is already running")
+		case GeneratorStateSuspendedStart:
+			if completion != CompletionTypeNormal {
+				g.State = GeneratorStateCompleted
+			}
+		}
+
+		if g.State == GeneratorStateCompleted {
+			switch completion {
+			case CompletionTypeReturn:
+				return NewObj(r.CreateIterResultObject(value, true))
+			case CompletionTypeThrow:
+				ThrowValue(value)
+			}
+			return NewObj(r.CreateIterResultObject(NewUndefined(), true))
+		}
+
+		g.State = GeneratorStateExecuting
+		defer func() {
+			if g.State == GeneratorStateExecuting {
+				g.State = GeneratorStateCompleted
+			}
+		}()
+
+		result, done := g.Resume(completion, value)
+		if done {
+			g.State = GeneratorStateCompleted
+		} else {
+			g.State = GeneratorStateSuspendedYield
+		}
+		return result
+	}
+}
+
+// https://tc39.es/ecma262/#sec-generatorvalidate
+func thisGeneratorObject(this Value, method string) *GeneratorObject {
+	if g, ok := this.Obj.(*GeneratorObject); ok && this.Type == ValueTypeObj {
+		return g
+	}
+	ThrowTypeError("Method Generator.prototype.%s called on incompatible receiver %s", method, this.String())
+	return nil
+}
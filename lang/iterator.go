@@ -0,0 +1,266 @@
+package lang
+
+import (
+	"strconv"
+	"unicode"
+	"unicode/utf16"
+)
+
+// https://tc39.es/ecma262/#sec-iterator-records
+type IteratorRecord struct {
+	Iterator   Object
+	NextMethod Value
+	Done       bool
+}
+
+// https://tc39.es/ecma262/#sec-getiterator
+func (r *Realm) GetIterator(v Value) *IteratorRecord {
+	method := r.GetMethod(v, SymbolKey(SymbolIterator))
+	if method.Type == ValueTypeUndefined {
+		ThrowTypeError("%s is not iterable", v.String())
+	}
+	return GetIteratorFromMethod(v, method)
+}
+
+// https://tc39.es/ecma262/#sec-getiteratorfrommethod
+func GetIteratorFromMethod(v Value, method Value) *IteratorRecord {
+	iterator := Call(method, v)
+	if iterator.Type != ValueTypeObj {
+		ThrowTypeError("Result of the Symbol.iterator method is not an object")
+	}
+	return &IteratorRecord{Iterator: iterator.Obj, NextMethod: iterator.Obj.Get("next", iterator)}
+}
+
+// StepValue advances the iterator and returns its next value. It reports
+// false once the iterator is done. The record is marked done as well when
+// advancing throws, so that the iterator is not closed afterwards.
+// https://tc39.es/ecma262/#sec-iteratorstepvalue
+func (ir *IteratorRecord) StepValue() (Value, bool) {
+	ir.Done = true
+
+	result := Call(ir.NextMethod, NewObj(ir.Iterator))
+	if result.Type != ValueTypeObj {
+		ThrowTypeError("Iterator result %s is not an object", result.String())
+	}
+
+	if ToBoolean(result.Obj.Get("done", result)) {
+		return NewUndefined(), false
+	}
+
+	value := result.Obj.Get("value", result)
+	ir.Done = false
+	return value, true
+}
+
+// https://tc39.es/ecma262/#sec-iteratorclose
+func (ir *IteratorRecord) Close() {
+	ret := ir.Iterator.Get("return", NewObj(ir.Iterator))
+	if ret.Type == ValueTypeUndefined || ret.Type == ValueTypeNull {
+		return
+	}
+
+	if result := Call(ret, NewObj(ir.Iterator)); result.Type != ValueTypeObj {
+		ThrowTypeError("Iterator result %s is not an object", result.String())
+	}
+}
+
+// CloseAfterThrow closes the iterator while an exception propagates. The
+// propagating exception takes precedence, so exceptions thrown while closing
+// are ignored.
+// https://tc39.es/ecma262/#sec-iteratorclose
+func (ir *IteratorRecord) CloseAfterThrow() {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(*Exception); !ok {
+				panic(r)
+			}
+		}
+	}()
+
+	ret := ir.Iterator.Get("return", NewObj(ir.Iterator))
+	if ret.Type != ValueTypeUndefined && ret.Type != ValueTypeNull {
+		Call(ret, NewObj(ir.Iterator))
+	}
+}
+
+// https://tc39.es/ecma262/#sec-iterabletolist
+func (r *Realm) IterableToList(v Value) []Value {
+	ir := r.GetIterator(v)
+
+	var values []Value
+	for value, ok := ir.StepValue(); ok; value, ok = ir.StepValue() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// https://tc39.es/ecma262/#sec-%iteratorprototype%-@@iterator
+func iteratorPrototypeIterator(this Value, args []Value) Value {
+	return this
+}
+
+// ForInIterator enumerates the enumerable string keys of an object and its
+// prototypes, skipping keys shadowed by objects earlier in the chain and
+// keys deleted before they are reached.
+// https://tc39.es/ecma262/#sec-createforiniterator
+type ForInIterator struct {
+	object           Object
+	objectWasVisited bool
+	visitedKeys      map[string]bool
+	remainingKeys    []string
+}
+
+// https://tc39.es/ecma262/#sec-enumerate-object-properties
+func CreateForInIterator(o Object) *ForInIterator {
+	return &ForInIterator{object: o, visitedKeys: make(map[string]bool)}
+}
+
+// Next returns the next key, reporting false once every object in the
+// prototype chain has been enumerated.
+// https://tc39.es/ecma262/#sec-%foriniteratorprototype%.next
+func (it *ForInIterator) Next() (string, bool) {
+	for it.object != nil {
+		if !it.objectWasVisited {
+			it.remainingKeys = it.object.OwnPropertyKeys()
+			it.objectWasVisited = true
+		}
+
+		for len(it.remainingKeys) > 0 {
+			key := it.remainingKeys[0]
+			it.remainingKeys = it.remainingKeys[1:]
+			if it.visitedKeys[key] {
+				continue
+			}
+
+			desc, ok := it.object.GetOwnProperty(key)
+			if !ok {
+				continue
+			}
+			it.visitedKeys[key] = true
+			if desc.Enumerable {
+				return key, true
+			}
+		}
+
+		it.object = it.object.GetPrototypeOf()
+		it.objectWasVisited = false
+	}
+	return "", false
+}
+
+// https://tc39.es/ecma262/#sec-createiterresultobject
+func (r *Realm) CreateIterResultObject(value Value, done bool) *JsObject {
+	o := r.NewObject()
+	CreateDataProperty(o, StrKey("value"), value)
+	CreateDataProperty(o, StrKey("done"), NewBool(done))
+	return o
+}
+
+type arrayIteratorKind int
+
+const (
+	arrayIteratorKindKey arrayIteratorKind = iota
+	arrayIteratorKindValue
+	arrayIteratorKindKeyValue
+)
+
+// https://tc39.es/ecma262/#sec-array-iterator-objects
+type arrayIterator struct {
+	JsObject
+	iteratedArrayLike Object
+	nextIndex         int64
+	kind              arrayIteratorKind
+}
+
+// https://tc39.es/ecma262/#sec-createarrayiterator
+func (r *Realm) newArrayIterator(array Object, kind arrayIteratorKind) *arrayIterator {
+	it := &arrayIterator{iteratedArrayLike: array, kind: kind}
+	it.init(r.ArrayIteratorPrototype)
+	return it
+}
+
+// https://tc39.es/ecma262/#sec-array.prototype.values
+// https://tc39.es/ecma262/#sec-array.prototype.keys
+// https://tc39.es/ecma262/#sec-array.prototype.entries
+func arrayPrototypeIterator(r *Realm, kind arrayIteratorKind) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		return NewObj(r.newArrayIterator(r.ToObject(this), kind))
+	}
+}
+
+// https://tc39.es/ecma262/#sec-%arrayiteratorprototype%.next
+func arrayIteratorNext(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		it, ok := this.Obj.(*arrayIterator)
+		if !ok || this.Type != ValueTypeObj {
+			ThrowTypeError("%%ArrayIteratorPrototype%%.next called on incompatible receiver")
+		}
+
+		if it.iteratedArrayLike == nil {
+			return NewObj(r.CreateIterResultObject(NewUndefined(), true))
+		}
+
+		a, index := it.iteratedArrayLike, it.nextIndex
+		if index >= LengthOfArrayLike(a) {
+			it.iteratedArrayLike = nil
+			return NewObj(r.CreateIterResultObject(NewUndefined(), true))
+		}
+		it.nextIndex++
+
+		key := strconv.FormatInt(index, 10)
+		switch it.kind {
+		case arrayIteratorKindKey:
+			return NewObj(r.CreateIterResultObject(NewNumber(float64(index)), false))
+		case arrayIteratorKindValue:
+			return NewObj(r.CreateIterResultObject(a.Get(key, NewObj(a)), false))
+		default:
+			entry := r.NewArray([]Value{NewNumber(float64(index)), a.Get(key, NewObj(a))})
+			return NewObj(r.CreateIterResultObject(NewObj(entry), false))
+		}
+	}
+}
+
+// https://tc39.es/ecma262/#sec-%stringiteratorprototype%-object
+type stringIterator struct {
+	JsObject
+	s        []uint16
+	position int
+	done     bool
+}
+
+// https://tc39.es/ecma262/#sec-string.prototype-@@iterator
+func stringPrototypeIterator(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		s := ToString(RequireObjectCoercible(this))
+		it := &stringIterator{s: toUTF16(s)}
+		it.init(r.StringIteratorPrototype)
+		return NewObj(it)
+	}
+}
+
+// The iterator yields the string one code point at a time.
+// https://tc39.es/ecma262/#sec-%stringiteratorprototype%.next
+func stringIteratorNext(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		it, ok := this.Obj.(*stringIterator)
+		if !ok || this.Type != ValueTypeObj {
+			ThrowTypeError("%%StringIteratorPrototype%%.next called on incompatible receiver")
+		}
+
+		if it.done || it.position >= len(it.s) {
+			it.done = true
+			return NewObj(r.CreateIterResultObject(NewUndefined(), true))
+		}
+
+		size := 1
+		if first := rune(it.s[it.position]); utf16.IsSurrogate(first) && it.position+1 < len(it.s) {
+			if utf16.DecodeRune(first, rune(it.s[it.position+1])) != unicode.ReplacementChar {
+				size = 2
+			}
+		}
+
+		codePoint := it.s[it.position : it.position+size]
+		it.position += size
+		return NewObj(r.CreateIterResultObject(NewStr(fromUTF16(codePoint)), false))
+	}
+}
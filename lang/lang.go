@@ -2,8 +2,8 @@ package lang
 
 import (
 	"fmt"
-	"gojs/ast"
-	"strconv"
+	"math"
+	"math/big"
 )
 
 type ValueType int
@@ -12,17 +12,28 @@ const (
 	ValueTypeUndefined ValueType = iota
 	ValueTypeNull
 	ValueTypeStr
-	ValueTypeInt
+	ValueTypeNumber
 	ValueTypeBool
 	ValueTypeObj
+	ValueTypeSymbol
+	ValueTypeBigInt
+
+	// valueTypeEmpty marks a hole in the dense element store of an Array.
+	valueTypeEmpty
 )
 
 type Value struct {
-	Type ValueType
-	Str  string
-	Int  int
-	Bool bool
-	Obj  Object
+	Type   ValueType
+	Str    string
+	Number float64
+	Bool   bool
+	Obj    Object
+	Symbol *Symbol
+	BigInt *big.Int
+
+	// info caches the measurements of a long String value, shared by its
+	// copies.
+	info *stringInfo
 }
 
 func (v Value) String() string {
@@ -30,8 +41,8 @@ func (v Value) String() string {
 		return v.Str
 	}
 
-	if v.Type == ValueTypeInt {
-		return fmt.Sprintf("%d", v.Int)
+	if v.Type == ValueTypeNumber {
+		return NumberToString(v.Number, 10)
 	}
 
 	if v.Type == ValueTypeBool {
@@ -42,6 +53,14 @@ func (v Value) String() string {
 		return "[object]"
 	}
 
+	if v.Type == ValueTypeSymbol {
+		return v.Symbol.DescriptiveString()
+	}
+
+	if v.Type == ValueTypeBigInt {
+		return v.BigInt.String()
+	}
+
 	if v.Type == ValueTypeUndefined {
 		return "undefined"
 	}
@@ -53,6 +72,33 @@ func (v Value) String() string {
 	return "[ILLEGAL]"
 }
 
+// https://tc39.es/ecma262/#sec-samevalue
+func SameValue(x, y Value) bool {
+	if x.Type != y.Type {
+		return false
+	}
+
+	switch x.Type {
+	case ValueTypeStr:
+		return x.Str == y.Str
+	case ValueTypeNumber:
+		if math.IsNaN(x.Number) && math.IsNaN(y.Number) {
+			return true
+		}
+		return x.Number == y.Number && math.Signbit(x.Number) == math.Signbit(y.Number)
+	case ValueTypeBool:
+		return x.Bool == y.Bool
+	case ValueTypeObj:
+		return x.Obj == y.Obj
+	case ValueTypeSymbol:
+		return x.Symbol == y.Symbol
+	case ValueTypeBigInt:
+		return x.BigInt.Cmp(y.BigInt) == 0
+	default:
+		return true
+	}
+}
+
 func NewUndefined() Value {
 	return Value{Type: ValueTypeUndefined}
 }
@@ -62,11 +108,18 @@ func NewNull() Value {
 }
 
 func NewStr(str string) Value {
+	if len(str) > shortString {
+		return Value{Type: ValueTypeStr, Str: str, info: &stringInfo{}}
+	}
 	return Value{Type: ValueTypeStr, Str: str}
 }
 
+func NewNumber(val float64) Value {
+	return Value{Type: ValueTypeNumber, Number: val}
+}
+
 func NewInt(val int) Value {
-	return Value{Type: ValueTypeInt, Int: val}
+	return NewNumber(float64(val))
 }
 
 func NewBool(val bool) Value {
@@ -77,74 +130,81 @@ func NewObj(obj Object) Value {
 	return Value{Type: ValueTypeObj, Obj: obj}
 }
 
-type Object interface {
-	_Object()
-	GetProperty(name string) Value
-	SetProperty(name string, value Value)
-}
-type JsObject struct {
-	Storage map[string]Value
-}
-
-func (j *JsObject) GetProperty(name string) Value {
-	return j.Storage[name]
-}
-
-func (j *JsObject) SetProperty(name string, value Value) {
-	j.Storage[name] = value
-}
-
-func (j *JsObject) _Object() {}
-
-type Function struct {
-	Name       string
-	Body       ast.Statement
-	Parameters []ast.Identifier
-}
-
-func (f *Function) GetProperty(name string) Value {
-	panic("nope")
-}
-
-func (f *Function) SetProperty(name string, value Value) {
-	panic("nope")
-}
-
-func (f *Function) _Object() {}
-
-type NativeFunction struct {
-	Function func(values ...Value)
-}
-
-func (f *NativeFunction) GetProperty(name string) Value {
-	panic("nope")
-}
-
-func (f *NativeFunction) SetProperty(name string, value Value) {
-	panic("nope")
-}
-
-func (f *NativeFunction) _Object() {}
-
-type Array struct {
-	Store []Value
-}
-
-func (a *Array) GetProperty(name string) Value {
-	idx, err := strconv.Atoi(name)
-	if err != nil {
-		panic(err)
+func NewSymbol(symbol *Symbol) Value {
+	return Value{Type: ValueTypeSymbol, Symbol: symbol}
+}
+
+// NewBigInt returns a BigInt value. BigInt values are immutable, so the
+// integer must not be modified afterwards.
+func NewBigInt(n *big.Int) Value {
+	return Value{Type: ValueTypeBigInt, BigInt: n}
+}
+
+// ToValue converts a Go value for use by scripts. 64-bit integers and big
+// integers become BigInts, as a Number cannot hold all of their values, and
+// the other integer and floating-point types become Numbers.
+func ToValue(v interface{}) Value {
+	switch v := v.(type) {
+	case nil:
+		return NewUndefined()
+	case Value:
+		return v
+	case bool:
+		return NewBool(v)
+	case string:
+		return NewStr(v)
+	case int:
+		return NewNumber(float64(v))
+	case int8:
+		return NewNumber(float64(v))
+	case int16:
+		return NewNumber(float64(v))
+	case int32:
+		return NewNumber(float64(v))
+	case uint:
+		return NewNumber(float64(v))
+	case uint8:
+		return NewNumber(float64(v))
+	case uint16:
+		return NewNumber(float64(v))
+	case uint32:
+		return NewNumber(float64(v))
+	case float32:
+		return NewNumber(float64(v))
+	case float64:
+		return NewNumber(v)
+	case int64:
+		return NewBigInt(big.NewInt(v))
+	case uint64:
+		return NewBigInt(new(big.Int).SetUint64(v))
+	case *big.Int:
+		return NewBigInt(new(big.Int).Set(v))
+	case Object:
+		return NewObj(v)
+	case *Symbol:
+		return NewSymbol(v)
+	default:
+		panic(fmt.Sprintf("cannot convert %T to a value", v))
 	}
-
-	return a.Store[idx]
 }
 
-func (a *Array) SetProperty(name string, value Value) {
-	idx, err := strconv.Atoi(name)
-	if err != nil {
-		panic(err)
+// Export converts a value to Go: undefined and null become nil, Numbers
+// float64, BigInts *big.Int, and objects and symbols are returned as they are.
+func (v Value) Export() interface{} {
+	switch v.Type {
+	case ValueTypeBool:
+		return v.Bool
+	case ValueTypeStr:
+		return v.Str
+	case ValueTypeNumber:
+		return v.Number
+	case ValueTypeBigInt:
+		return new(big.Int).Set(v.BigInt)
+	case ValueTypeObj:
+		return v.Obj
+	case ValueTypeSymbol:
+		return v.Symbol
+	default:
+		return nil
 	}
-	a.Store[idx] = value
 }
-
-func (a *Array) _Object() {}
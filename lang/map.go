@@ -0,0 +1,359 @@
+package lang
+
+import (
+	"math"
+	"sort"
+)
+
+// mapEntry is an entry of a Map or Set. Deleted entries are marked empty
+// until the entries are compacted.
+type mapEntry struct {
+	key, value Value
+	empty      bool
+}
+
+// mapKey identifies a key under SameValueZero, so -0 and +0 are the same
+// key, as are all NaN values.
+type mapKey struct {
+	kind   ValueType
+	str    string
+	number float64
+	nan    bool
+	bool   bool
+	obj    Object
+	symbol *Symbol
+}
+
+func newMapKey(v Value) mapKey {
+	k := mapKey{kind: v.Type, str: v.Str, bool: v.Bool, obj: v.Obj, symbol: v.Symbol}
+	if v.Type == ValueTypeBigInt {
+		k.str = v.BigInt.String()
+	}
+	if v.Type == ValueTypeNumber {
+		if math.IsNaN(v.Number) {
+			k.nan = true
+		} else if v.Number != 0 {
+			k.number = v.Number
+		}
+	}
+	return k
+}
+
+// minCompaction is the number of empty entries below which the entries are
+// not compacted.
+const minCompaction = 16
+
+// compaction records the positions of the entries a compaction removed, so
+// that the cursors from before it can move to the same entry after it. Each
+// compaction links to the next one, and is only reachable from the cursors
+// from before it.
+type compaction struct {
+	removed []int
+	next    *compaction
+}
+
+// mapData holds the entries of a Map or Set in insertion order.
+// https://tc39.es/ecma262/#sec-map-objects
+type mapData struct {
+	entries    []*mapEntry
+	index      map[mapKey]*mapEntry
+	size       int
+	compaction *compaction
+}
+
+func newMapData() mapData {
+	return mapData{index: make(map[mapKey]*mapEntry), compaction: &compaction{}}
+}
+
+func (m *mapData) get(key Value) (*mapEntry, bool) {
+	entry, ok := m.index[newMapKey(key)]
+	return entry, ok
+}
+
+// set updates the value of the key, adding an entry when the key is not
+// present. -0 is normalized to +0 when added.
+func (m *mapData) set(key, value Value) {
+	if entry, ok := m.get(key); ok {
+		entry.value = value
+		return
+	}
+
+	if key.Type == ValueTypeNumber && key.Number == 0 {
+		key = NewNumber(0)
+	}
+	entry := &mapEntry{key: key, value: value}
+	m.entries = append(m.entries, entry)
+	m.index[newMapKey(key)] = entry
+	m.size++
+}
+
+func (m *mapData) delete(key Value) bool {
+	entry, ok := m.get(key)
+	if !ok {
+		return false
+	}
+
+	entry.empty = true
+	entry.key, entry.value = NewUndefined(), NewUndefined()
+	delete(m.index, newMapKey(key))
+	m.size--
+
+	if empty := len(m.entries) - m.size; empty >= minCompaction && empty >= m.size {
+		m.compact()
+	}
+	return true
+}
+
+func (m *mapData) clear() {
+	for _, entry := range m.entries {
+		entry.empty = true
+		entry.key, entry.value = NewUndefined(), NewUndefined()
+	}
+	m.index = make(map[mapKey]*mapEntry)
+	m.size = 0
+	m.compact()
+}
+
+// compact removes the empty entries, recording their positions for the
+// cursors over the entries.
+func (m *mapData) compact() {
+	var removed []int
+	entries := make([]*mapEntry, 0, m.size)
+	for idx, entry := range m.entries {
+		if entry.empty {
+			removed = append(removed, idx)
+		} else {
+			entries = append(entries, entry)
+		}
+	}
+	m.entries = entries
+
+	m.compaction.removed = removed
+	m.compaction.next = &compaction{}
+	m.compaction = m.compaction.next
+}
+
+// forEach calls f with each entry, including the ones added while iterating.
+func (m *mapData) forEach(f func(entry *mapEntry)) {
+	c := m.cursor()
+	for entry, ok := c.next(); ok; entry, ok = c.next() {
+		f(entry)
+	}
+}
+
+func (m *mapData) cursor() mapCursor {
+	return mapCursor{data: m, compaction: m.compaction}
+}
+
+// mapCursor is a position in the entries of a Map or Set, which stays at
+// the same entry when the entries are compacted.
+type mapCursor struct {
+	data       *mapData
+	nextIndex  int
+	compaction *compaction
+}
+
+// next returns the next entry that is not empty, and false once there are
+// none left, after which it stays done.
+func (c *mapCursor) next() (*mapEntry, bool) {
+	if c.data == nil {
+		return nil, false
+	}
+
+	for ; c.compaction.next != nil; c.compaction = c.compaction.next {
+		c.nextIndex -= sort.SearchInts(c.compaction.removed, c.nextIndex)
+	}
+
+	for c.nextIndex < len(c.data.entries) {
+		entry := c.data.entries[c.nextIndex]
+		c.nextIndex++
+		if !entry.empty {
+			return entry, true
+		}
+	}
+
+	c.data, c.compaction = nil, nil
+	return nil, false
+}
+
+// MapObject is a Map instance.
+// https://tc39.es/ecma262/#sec-properties-of-map-instances
+type MapObject struct {
+	JsObject
+	mapData
+}
+
+func (r *Realm) initMap() {
+	r.MapPrototype = r.NewObject()
+
+	// https://tc39.es/ecma262/#sec-map-iterable
+	ctor := r.defineBuiltinConstructor("Map", 0, func(this Value, args []Value) Value {
+		ThrowTypeError("Constructor Map requires 'new'")
+		return NewUndefined()
+	}, r.MapPrototype)
+	ctor.Constructor = func(args []Value, newTarget Object) Value {
+		m := &MapObject{mapData: newMapData()}
+		m.init(GetPrototypeFromConstructor(newTarget, r.MapPrototype))
+
+		if iterable := argument(args, 0); iterable.Type != ValueTypeUndefined && iterable.Type != ValueTypeNull {
+			r.addEntriesFromIterable(m, iterable, m.Get("set", NewObj(m)))
+		}
+		return NewObj(m)
+	}
+
+	p := r.MapPrototype
+	r.defineBuiltinMethod(p, "clear", 0, mapPrototypeClear)
+	r.defineBuiltinMethod(p, "delete", 1, mapPrototypeDelete)
+	r.defineBuiltinMethod(p, "forEach", 1, mapPrototypeForEach)
+	r.defineBuiltinMethod(p, "get", 1, mapPrototypeGet)
+	r.defineBuiltinMethod(p, "has", 1, mapPrototypeHas)
+	r.defineBuiltinMethod(p, "set", 2, mapPrototypeSet)
+	r.defineBuiltinGetter(p, "size", mapPrototypeSize)
+	r.defineBuiltinMethod(p, "keys", 0, mapPrototypeIterator(r, arrayIteratorKindKey))
+	r.defineBuiltinMethod(p, "values", 0, mapPrototypeIterator(r, arrayIteratorKindValue))
+	entries := r.defineBuiltinMethod(p, "entries", 0, mapPrototypeIterator(r, arrayIteratorKindKeyValue))
+	SymbolKey(SymbolIterator).DefineOwnProperty(p, NewDataDescriptor(NewObj(entries), true, false, true))
+	r.defineToStringTag(p, "Map")
+	r.defineBuiltinSpeciesGetter(ctor)
+
+	r.MapIteratorPrototype = NewJsObject(r.IteratorPrototype)
+	r.defineBuiltinMethod(r.MapIteratorPrototype, "next", 0, mapIteratorNext(r))
+	r.defineToStringTag(r.MapIteratorPrototype, "Map Iterator")
+}
+
+// https://tc39.es/ecma262/#sec-add-entries-from-iterable
+func (r *Realm) addEntriesFromIterable(target Object, iterable Value, adder Value) {
+	if !IsCallable(adder) {
+		ThrowTypeError("'%s' returned for property 'set' of object is not a function", adder.String())
+	}
+
+	ir := r.GetIterator(iterable)
+	for {
+		next, ok := ir.StepValue()
+		if !ok {
+			return
+		}
+
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					ir.CloseAfterThrow()
+					panic(r)
+				}
+			}()
+
+			if next.Type != ValueTypeObj {
+				ThrowTypeError("Iterator value %s is not an entry object", next.String())
+			}
+			k, v := next.Obj.Get("0", next), next.Obj.Get("1", next)
+			Call(adder, NewObj(target), k, v)
+		}()
+	}
+}
+
+func thisMapObject(this Value, method string) *MapObject {
+	if m, ok := this.Obj.(*MapObject); ok && this.Type == ValueTypeObj {
+		return m
+	}
+	ThrowTypeError("Method Map.prototype.%s called on incompatible receiver %s", method, this.String())
+	return nil
+}
+
+// https://tc39.es/ecma262/#sec-map.prototype.clear
+func mapPrototypeClear(this Value, args []Value) Value {
+	thisMapObject(this, "clear").clear()
+	return NewUndefined()
+}
+
+// https://tc39.es/ecma262/#sec-map.prototype.delete
+func mapPrototypeDelete(this Value, args []Value) Value {
+	return NewBool(thisMapObject(this, "delete").delete(argument(args, 0)))
+}
+
+// https://tc39.es/ecma262/#sec-map.prototype.foreach
+func mapPrototypeForEach(this Value, args []Value) Value {
+	m := thisMapObject(this, "forEach")
+	callback := argument(args, 0)
+	if !IsCallable(callback) {
+		ThrowTypeError("%s is not a function", callback.String())
+	}
+
+	m.forEach(func(entry *mapEntry) {
+		Call(callback, argument(args, 1), entry.value, entry.key, this)
+	})
+	return NewUndefined()
+}
+
+// https://tc39.es/ecma262/#sec-map.prototype.get
+func mapPrototypeGet(this Value, args []Value) Value {
+	if entry, ok := thisMapObject(this, "get").get(argument(args, 0)); ok {
+		return entry.value
+	}
+	return NewUndefined()
+}
+
+// https://tc39.es/ecma262/#sec-map.prototype.has
+func mapPrototypeHas(this Value, args []Value) Value {
+	_, ok := thisMapObject(this, "has").get(argument(args, 0))
+	return NewBool(ok)
+}
+
+// https://tc39.es/ecma262/#sec-map.prototype.set
+func mapPrototypeSet(this Value, args []Value) Value {
+	thisMapObject(this, "set").set(argument(args, 0), argument(args, 1))
+	return this
+}
+
+// https://tc39.es/ecma262/#sec-get-map.prototype.size
+func mapPrototypeSize(this Value, args []Value) Value {
+	return NewInt(thisMapObject(this, "size").size)
+}
+
+// mapIterator iterates over the entries of a Map or Set, yielding keys,
+// values or entries like the iterators of arrays do.
+// https://tc39.es/ecma262/#sec-map-iterator-objects
+// https://tc39.es/ecma262/#sec-set-iterator-objects
+type mapIterator struct {
+	JsObject
+	cursor mapCursor
+	kind   arrayIteratorKind
+	set    bool
+}
+
+// https://tc39.es/ecma262/#sec-createmapiterator
+func mapPrototypeIterator(r *Realm, kind arrayIteratorKind) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		m := thisMapObject(this, "entries")
+		it := &mapIterator{cursor: m.cursor(), kind: kind}
+		it.init(r.MapIteratorPrototype)
+		return NewObj(it)
+	}
+}
+
+// https://tc39.es/ecma262/#sec-%mapiteratorprototype%.next
+func mapIteratorNext(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		it, ok := this.Obj.(*mapIterator)
+		if !ok || this.Type != ValueTypeObj || it.set {
+			ThrowTypeError("%%MapIteratorPrototype%%.next called on incompatible receiver")
+		}
+		return it.next(r)
+	}
+}
+
+func (it *mapIterator) next(r *Realm) Value {
+	entry, ok := it.cursor.next()
+	if !ok {
+		return NewObj(r.CreateIterResultObject(NewUndefined(), true))
+	}
+
+	switch it.kind {
+	case arrayIteratorKindKey:
+		return NewObj(r.CreateIterResultObject(entry.key, false))
+	case arrayIteratorKindValue:
+		return NewObj(r.CreateIterResultObject(entry.value, false))
+	default:
+		pair := r.NewArray([]Value{entry.key, entry.value})
+		return NewObj(r.CreateIterResultObject(NewObj(pair), false))
+	}
+}
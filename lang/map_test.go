@@ -0,0 +1,50 @@
+package lang
+
+import "testing"
+
+func TestMapDataCompaction(t *testing.T) {
+	m := newMapData()
+	for idx := 0; idx < 100000; idx++ {
+		m.set(NewInt(idx), NewInt(idx))
+		m.delete(NewInt(idx))
+	}
+	if len(m.entries) > minCompaction {
+		t.Errorf("got %d entries after deleting every key, want at most %d", len(m.entries), minCompaction)
+	}
+}
+
+func TestMapCursorCompaction(t *testing.T) {
+	m := newMapData()
+	for idx := 0; idx < 100; idx++ {
+		m.set(NewInt(idx), NewInt(idx))
+	}
+
+	c := m.cursor()
+	for idx := 0; idx < 10; idx++ {
+		c.next()
+	}
+	for idx := 0; idx < 95; idx++ {
+		m.delete(NewInt(idx))
+	}
+	m.set(NewInt(100), NewInt(100))
+
+	var got []int
+	for entry, ok := c.next(); ok; entry, ok = c.next() {
+		got = append(got, int(entry.key.Number))
+	}
+	want := []int{95, 96, 97, 98, 99, 100}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for idx := range want {
+		if got[idx] != want[idx] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+
+	m.clear()
+	m.set(NewInt(1), NewInt(1))
+	if _, ok := c.next(); ok {
+		t.Errorf("cursor continued after it was done")
+	}
+}
@@ -0,0 +1,218 @@
+package lang
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// https://tc39.es/ecma262/#sec-numeric-types-number-tostring
+func NumberToString(x float64, radix int) string {
+	if math.IsNaN(x) {
+		return "NaN"
+	}
+
+	if x == 0 {
+		return "0"
+	}
+
+	if x < 0 {
+		return "-" + NumberToString(-x, radix)
+	}
+
+	if math.IsInf(x, 1) {
+		return "Infinity"
+	}
+
+	if radix != 10 {
+		return numberToRadixString(x, radix)
+	}
+
+	// The shortest round-tripping representation gives the digits s and the
+	// exponent n such that s × 10^(n-k) is x.
+	repr := strconv.FormatFloat(x, 'e', -1, 64)
+	mantissa, exponent := repr, ""
+	if idx := strings.IndexByte(repr, 'e'); idx >= 0 {
+		mantissa, exponent = repr[:idx], repr[idx+1:]
+	}
+	digits := strings.Replace(mantissa, ".", "", 1)
+	e, _ := strconv.Atoi(exponent)
+	k, n := len(digits), e+1
+
+	switch {
+	case k <= n && n <= 21:
+		return digits + strings.Repeat("0", n-k)
+	case 0 < n && n <= 21:
+		return digits[:n] + "." + digits[n:]
+	case -6 < n && n <= 0:
+		return "0." + strings.Repeat("0", -n) + digits
+	}
+
+	sign := "+"
+	if n-1 < 0 {
+		sign = "-"
+	}
+	exp := strconv.Itoa(abs(n - 1))
+
+	if k == 1 {
+		return digits + "e" + sign + exp
+	}
+	return digits[:1] + "." + digits[1:] + "e" + sign + exp
+}
+
+// numberToRadixString formats a finite positive number in the given radix.
+// The fraction is cut off once it no longer contributes to the value.
+func numberToRadixString(x float64, radix int) string {
+	integer, fraction := math.Modf(x)
+
+	i, _ := new(big.Float).SetFloat64(integer).Int(nil)
+	str := i.Text(radix)
+	if fraction == 0 {
+		return str
+	}
+
+	digits := make([]byte, 0, 52)
+	delta := math.Max((math.Nextafter(x, math.Inf(1))-x)/2, math.SmallestNonzeroFloat64)
+	for fraction >= delta && len(digits) < 1100 {
+		fraction *= float64(radix)
+		delta *= float64(radix)
+		digit := int(fraction)
+		fraction -= float64(digit)
+		digits = append(digits, strconv.FormatInt(int64(digit), radix)[0])
+	}
+
+	return str + "." + string(digits)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// https://tc39.es/ecma262/#sec-white-space
+// https://tc39.es/ecma262/#sec-line-terminators
+func isStrWhiteSpaceChar(r rune) bool {
+	switch r {
+	case '\u0009', '\u000B', '\u000C', '\uFEFF', '\u000A', '\u000D', '\u2028', '\u2029':
+		return true
+	}
+
+	return unicode.Is(unicode.Zs, r)
+}
+
+// https://tc39.es/ecma262/#sec-stringtonumber
+func StringToNumber(str string) float64 {
+	str = strings.TrimFunc(str, isStrWhiteSpaceChar)
+	if len(str) == 0 {
+		return 0
+	}
+
+	if len(str) > 2 && str[0] == '0' {
+		radix := 0
+		switch str[1] {
+		case 'x', 'X':
+			radix = 16
+		case 'o', 'O':
+			radix = 8
+		case 'b', 'B':
+			radix = 2
+		}
+
+		if radix != 0 {
+			return parseNonDecimalDigits(str[2:], radix)
+		}
+	}
+
+	unsigned := str
+	if str[0] == '+' || str[0] == '-' {
+		unsigned = str[1:]
+	}
+
+	if unsigned == "Infinity" {
+		if str[0] == '-' {
+			return math.Inf(-1)
+		}
+		return math.Inf(1)
+	}
+
+	if !isStrUnsignedDecimalLiteral(unsigned) {
+		return math.NaN()
+	}
+
+	value, _ := strconv.ParseFloat(str, 64)
+	return value
+}
+
+// parseNonDecimalDigits returns the value of the digits, correctly rounded,
+// or NaN when they contain a character that is not a digit in the radix.
+func parseNonDecimalDigits(digits string, radix int) float64 {
+	for _, ch := range digits {
+		if d := digitValue(ch); d < 0 || d >= radix {
+			return math.NaN()
+		}
+	}
+
+	i, _ := new(big.Int).SetString(digits, radix)
+	f, _ := new(big.Float).SetInt(i).Float64()
+	return f
+}
+
+func digitValue(ch rune) int {
+	switch {
+	case ch >= '0' && ch <= '9':
+		return int(ch - '0')
+	case ch >= 'a' && ch <= 'z':
+		return int(ch-'a') + 10
+	case ch >= 'A' && ch <= 'Z':
+		return int(ch-'A') + 10
+	default:
+		return -1
+	}
+}
+
+// https://tc39.es/ecma262/#prod-StrUnsignedDecimalLiteral
+func isStrUnsignedDecimalLiteral(str string) bool {
+	idx, digits := 0, 0
+	for idx < len(str) && isDecimalDigit(str[idx]) {
+		idx++
+		digits++
+	}
+
+	if idx < len(str) && str[idx] == '.' {
+		idx++
+		for idx < len(str) && isDecimalDigit(str[idx]) {
+			idx++
+			digits++
+		}
+	}
+
+	if digits == 0 {
+		return false
+	}
+
+	if idx < len(str) && (str[idx] == 'e' || str[idx] == 'E') {
+		idx++
+		if idx < len(str) && (str[idx] == '+' || str[idx] == '-') {
+			idx++
+		}
+
+		exponent := idx
+		for idx < len(str) && isDecimalDigit(str[idx]) {
+			idx++
+		}
+
+		if idx == exponent {
+			return false
+		}
+	}
+
+	return idx == len(str)
+}
+
+func isDecimalDigit(ch byte) bool {
+	return ch >= '0' && ch <= '9'
+}
@@ -0,0 +1,754 @@
+package lang
+
+import (
+	"sort"
+	"strconv"
+)
+
+// https://tc39.es/ecma262/#sec-object-internal-methods-and-internal-slots
+type Object interface {
+	_Object()
+	GetPrototypeOf() Object
+	SetPrototypeOf(prototype Object) bool
+	IsExtensible() bool
+	PreventExtensions() bool
+	GetOwnProperty(name string) (PropertyDescriptor, bool)
+	DefineOwnProperty(name string, desc PropertyDescriptor) bool
+	HasProperty(name string) bool
+	Get(name string, receiver Value) Value
+	Set(name string, value Value, receiver Value) bool
+	Delete(name string) bool
+	OwnPropertyKeys() []string
+}
+
+// Callable is implemented by objects with a [[Call]] internal method.
+type Callable interface {
+	Object
+	Call(this Value, args []Value) Value
+}
+
+// Constructor is implemented by objects with a [[Construct]] internal method.
+type Constructor interface {
+	Callable
+	Construct(args []Value, newTarget Object) Value
+}
+
+// PropertyKey is a String, or a Symbol when Symbol is set.
+//
+// The internal methods of objects take String keys, which exotic objects give
+// meaning to. Properties keyed by symbols are always ordinary, so the methods
+// of a symbol key use the ordinary part of the object instead.
+// https://tc39.es/ecma262/#sec-object-type
+type PropertyKey struct {
+	Str    string
+	Symbol *Symbol
+}
+
+func StrKey(name string) PropertyKey {
+	return PropertyKey{Str: name}
+}
+
+func SymbolKey(symbol *Symbol) PropertyKey {
+	return PropertyKey{Symbol: symbol}
+}
+
+func (k PropertyKey) Value() Value {
+	if k.Symbol != nil {
+		return NewSymbol(k.Symbol)
+	}
+	return NewStr(k.Str)
+}
+
+func (k PropertyKey) String() string {
+	if k.Symbol != nil {
+		return k.Symbol.DescriptiveString()
+	}
+	return k.Str
+}
+
+// FunctionName is the name given to a function defined by a property with
+// the key.
+// https://tc39.es/ecma262/#sec-setfunctionname
+func (k PropertyKey) FunctionName() string {
+	if k.Symbol == nil {
+		return k.Str
+	}
+	if k.Symbol.Description.Type == ValueTypeUndefined {
+		return ""
+	}
+	return "[" + k.Symbol.Description.Str + "]"
+}
+
+func (k PropertyKey) GetOwnProperty(o Object) (PropertyDescriptor, bool) {
+	if k.Symbol == nil {
+		return o.GetOwnProperty(k.Str)
+	}
+	desc, ok := ordinaryObject(o).symbolProperties[k.Symbol]
+	return desc, ok
+}
+
+func (k PropertyKey) DefineOwnProperty(o Object, desc PropertyDescriptor) bool {
+	if k.Symbol == nil {
+		return o.DefineOwnProperty(k.Str, desc)
+	}
+	current, ok := k.GetOwnProperty(o)
+	return ordinaryObject(o).validateAndApplyPropertyDescriptor(k, o.IsExtensible(), desc, current, ok)
+}
+
+func (k PropertyKey) HasProperty(o Object) bool {
+	if k.Symbol == nil {
+		return o.HasProperty(k.Str)
+	}
+	return ordinaryHasProperty(o, k)
+}
+
+func (k PropertyKey) Get(o Object, receiver Value) Value {
+	if k.Symbol == nil {
+		return o.Get(k.Str, receiver)
+	}
+	return ordinaryGet(o, k, receiver)
+}
+
+func (k PropertyKey) Set(o Object, value Value, receiver Value) bool {
+	if k.Symbol == nil {
+		return o.Set(k.Str, value, receiver)
+	}
+	return ordinarySet(o, k, value, receiver)
+}
+
+func (k PropertyKey) Delete(o Object) bool {
+	if k.Symbol == nil {
+		return o.Delete(k.Str)
+	}
+	return ordinaryObject(o).ordinaryDelete(k)
+}
+
+// OwnPropertyKeys returns the String keys of the object followed by its
+// Symbol keys.
+// https://tc39.es/ecma262/#sec-ordinaryownpropertykeys
+func OwnPropertyKeys(o Object) []PropertyKey {
+	var keys []PropertyKey
+	for _, name := range o.OwnPropertyKeys() {
+		keys = append(keys, StrKey(name))
+	}
+	for _, s := range ordinaryObject(o).symbols {
+		keys = append(keys, SymbolKey(s))
+	}
+	return keys
+}
+
+// https://tc39.es/ecma262/#sec-property-descriptor-specification-type
+type PropertyDescriptor struct {
+	Value                              Value
+	Getter, Setter                     Object
+	Writable, Enumerable, Configurable bool
+
+	HasValue, HasGetter, HasSetter              bool
+	HasWritable, HasEnumerable, HasConfigurable bool
+}
+
+func NewDataDescriptor(value Value, writable, enumerable, configurable bool) PropertyDescriptor {
+	return PropertyDescriptor{
+		Value:           value,
+		Writable:        writable,
+		Enumerable:      enumerable,
+		Configurable:    configurable,
+		HasValue:        true,
+		HasWritable:     true,
+		HasEnumerable:   true,
+		HasConfigurable: true,
+	}
+}
+
+func NewAccessorDescriptor(getter, setter Object, enumerable, configurable bool) PropertyDescriptor {
+	return PropertyDescriptor{
+		Getter:          getter,
+		Setter:          setter,
+		Enumerable:      enumerable,
+		Configurable:    configurable,
+		HasGetter:       true,
+		HasSetter:       true,
+		HasEnumerable:   true,
+		HasConfigurable: true,
+	}
+}
+
+// https://tc39.es/ecma262/#sec-isaccessordescriptor
+func (d PropertyDescriptor) IsAccessorDescriptor() bool {
+	return d.HasGetter || d.HasSetter
+}
+
+// https://tc39.es/ecma262/#sec-isdatadescriptor
+func (d PropertyDescriptor) IsDataDescriptor() bool {
+	return d.HasValue || d.HasWritable
+}
+
+// https://tc39.es/ecma262/#sec-isgenericdescriptor
+func (d PropertyDescriptor) IsGenericDescriptor() bool {
+	return !d.IsAccessorDescriptor() && !d.IsDataDescriptor()
+}
+
+// https://tc39.es/ecma262/#sec-completepropertydescriptor
+func (d PropertyDescriptor) complete() PropertyDescriptor {
+	if d.IsGenericDescriptor() || d.IsDataDescriptor() {
+		d.HasValue, d.HasWritable = true, true
+	} else {
+		d.HasGetter, d.HasSetter = true, true
+	}
+	d.HasEnumerable, d.HasConfigurable = true, true
+	return d
+}
+
+// https://tc39.es/ecma262/#sec-array-index
+func IsArrayIndex(name string) (uint32, bool) {
+	if len(name) == 0 || len(name) > 10 || (len(name) > 1 && name[0] == '0') {
+		return 0, false
+	}
+
+	idx, err := strconv.ParseUint(name, 10, 32)
+	if err != nil || idx == 1<<32-1 {
+		return 0, false
+	}
+
+	return uint32(idx), true
+}
+
+// JsObject is an ordinary object.
+// https://tc39.es/ecma262/#sec-ordinary-object-internal-methods-and-internal-slots
+type JsObject struct {
+	prototype  Object
+	extensible bool
+	keys       []string
+	properties map[string]PropertyDescriptor
+
+	// Properties keyed by symbols are created on first use.
+	symbols          []*Symbol
+	symbolProperties map[*Symbol]PropertyDescriptor
+
+	// https://tc39.es/ecma262/#sec-privateelement-specification-type
+	privateElements []*PrivateElement
+}
+
+func NewJsObject(prototype Object) *JsObject {
+	o := &JsObject{}
+	o.init(prototype)
+	return o
+}
+
+func (j *JsObject) init(prototype Object) {
+	j.prototype = prototype
+	j.extensible = true
+	j.properties = make(map[string]PropertyDescriptor)
+}
+
+func (j *JsObject) _Object() {}
+
+// ordinary is promoted to every object embedding JsObject, giving access to
+// the ordinary part of the object regardless of the embedding type.
+func (j *JsObject) ordinary() *JsObject {
+	return j
+}
+
+// https://tc39.es/ecma262/#sec-ordinary-object-internal-methods-and-internal-slots-getprototypeof
+func (j *JsObject) GetPrototypeOf() Object {
+	return j.prototype
+}
+
+// https://tc39.es/ecma262/#sec-ordinarysetprototypeof
+func (j *JsObject) SetPrototypeOf(prototype Object) bool {
+	if prototype == j.prototype {
+		return true
+	}
+
+	if !j.extensible {
+		return false
+	}
+
+	for p := prototype; p != nil; p = p.GetPrototypeOf() {
+		if o, ok := p.(interface{ ordinary() *JsObject }); ok && o.ordinary() == j {
+			return false
+		}
+	}
+
+	j.prototype = prototype
+	return true
+}
+
+// https://tc39.es/ecma262/#sec-ordinaryisextensible
+func (j *JsObject) IsExtensible() bool {
+	return j.extensible
+}
+
+// https://tc39.es/ecma262/#sec-ordinarypreventextensions
+func (j *JsObject) PreventExtensions() bool {
+	j.extensible = false
+	return true
+}
+
+// https://tc39.es/ecma262/#sec-ordinarygetownproperty
+func (j *JsObject) GetOwnProperty(name string) (PropertyDescriptor, bool) {
+	desc, ok := j.properties[name]
+	return desc, ok
+}
+
+// https://tc39.es/ecma262/#sec-ordinarydefineownproperty
+func (j *JsObject) DefineOwnProperty(name string, desc PropertyDescriptor) bool {
+	current, ok := j.GetOwnProperty(name)
+	return j.validateAndApplyPropertyDescriptor(StrKey(name), j.extensible, desc, current, ok)
+}
+
+// https://tc39.es/ecma262/#sec-ordinaryhasproperty
+func (j *JsObject) HasProperty(name string) bool {
+	return OrdinaryHasProperty(j, name)
+}
+
+func (j *JsObject) Get(name string, receiver Value) Value {
+	return OrdinaryGet(j, name, receiver)
+}
+
+func (j *JsObject) Set(name string, value Value, receiver Value) bool {
+	return OrdinarySet(j, name, value, receiver)
+}
+
+func (j *JsObject) Delete(name string) bool {
+	return j.ordinaryDelete(StrKey(name))
+}
+
+// https://tc39.es/ecma262/#sec-ordinarydelete
+func (j *JsObject) ordinaryDelete(key PropertyKey) bool {
+	desc, ok := key.GetOwnProperty(j)
+	if !ok {
+		return true
+	}
+
+	if !desc.Configurable {
+		return false
+	}
+
+	if key.Symbol != nil {
+		delete(j.symbolProperties, key.Symbol)
+		for idx, s := range j.symbols {
+			if s == key.Symbol {
+				j.symbols = append(j.symbols[:idx], j.symbols[idx+1:]...)
+				break
+			}
+		}
+		return true
+	}
+
+	delete(j.properties, key.Str)
+	for idx, name := range j.keys {
+		if name == key.Str {
+			j.keys = append(j.keys[:idx], j.keys[idx+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// https://tc39.es/ecma262/#sec-ordinaryownpropertykeys
+func (j *JsObject) OwnPropertyKeys() []string {
+	indices := make([]uint32, 0)
+	names := make([]string, 0, len(j.keys))
+	for _, key := range j.keys {
+		if idx, ok := IsArrayIndex(key); ok {
+			indices = append(indices, idx)
+		} else {
+			names = append(names, key)
+		}
+	}
+
+	sort.Slice(indices, func(a, b int) bool { return indices[a] < indices[b] })
+
+	keys := make([]string, 0, len(j.keys))
+	for _, idx := range indices {
+		keys = append(keys, strconv.FormatUint(uint64(idx), 10))
+	}
+	return append(keys, names...)
+}
+
+// https://tc39.es/ecma262/#sec-validateandapplypropertydescriptor
+func (j *JsObject) validateAndApplyPropertyDescriptor(key PropertyKey, extensible bool, desc, current PropertyDescriptor, exists bool) bool {
+	if !IsCompatiblePropertyDescriptor(extensible, desc, current, exists) {
+		return false
+	}
+
+	if !exists {
+		j.putOwnProperty(key, desc.complete(), true)
+		return true
+	}
+
+	if current.IsDataDescriptor() && desc.IsAccessorDescriptor() {
+		current = PropertyDescriptor{
+			Configurable:    current.Configurable,
+			Enumerable:      current.Enumerable,
+			HasConfigurable: true,
+			HasEnumerable:   true,
+			HasGetter:       true,
+			HasSetter:       true,
+		}
+	} else if current.IsAccessorDescriptor() && desc.IsDataDescriptor() {
+		current = PropertyDescriptor{
+			Configurable:    current.Configurable,
+			Enumerable:      current.Enumerable,
+			HasConfigurable: true,
+			HasEnumerable:   true,
+			HasValue:        true,
+			HasWritable:     true,
+		}
+	}
+
+	if desc.HasValue {
+		current.Value = desc.Value
+	}
+	if desc.HasWritable {
+		current.Writable = desc.Writable
+	}
+	if desc.HasGetter {
+		current.Getter = desc.Getter
+	}
+	if desc.HasSetter {
+		current.Setter = desc.Setter
+	}
+	if desc.HasEnumerable {
+		current.Enumerable = desc.Enumerable
+	}
+	if desc.HasConfigurable {
+		current.Configurable = desc.Configurable
+	}
+
+	j.putOwnProperty(key, current, false)
+	return true
+}
+
+// putOwnProperty stores the descriptor of a property, appending a new
+// property to the order in which keys are enumerated.
+func (j *JsObject) putOwnProperty(key PropertyKey, desc PropertyDescriptor, new bool) {
+	if key.Symbol == nil {
+		if new {
+			j.keys = append(j.keys, key.Str)
+		}
+		j.properties[key.Str] = desc
+		return
+	}
+
+	if j.symbolProperties == nil {
+		j.symbolProperties = make(map[*Symbol]PropertyDescriptor)
+	}
+	if new {
+		j.symbols = append(j.symbols, key.Symbol)
+	}
+	j.symbolProperties[key.Symbol] = desc
+}
+
+// IsCompatiblePropertyDescriptor performs the validation steps of
+// ValidateAndApplyPropertyDescriptor without applying the descriptor.
+// https://tc39.es/ecma262/#sec-iscompatiblepropertydescriptor
+func IsCompatiblePropertyDescriptor(extensible bool, desc, current PropertyDescriptor, exists bool) bool {
+	if !exists {
+		return extensible
+	}
+
+	if current.Configurable {
+		return true
+	}
+
+	if desc.HasConfigurable && desc.Configurable {
+		return false
+	}
+
+	if desc.HasEnumerable && desc.Enumerable != current.Enumerable {
+		return false
+	}
+
+	if !desc.IsGenericDescriptor() && desc.IsAccessorDescriptor() != current.IsAccessorDescriptor() {
+		return false
+	}
+
+	if current.IsAccessorDescriptor() {
+		if desc.HasGetter && desc.Getter != current.Getter {
+			return false
+		}
+
+		return !desc.HasSetter || desc.Setter == current.Setter
+	}
+
+	if !current.Writable {
+		if desc.HasWritable && desc.Writable {
+			return false
+		}
+
+		return !desc.HasValue || SameValue(desc.Value, current.Value)
+	}
+
+	return true
+}
+
+// https://tc39.es/ecma262/#sec-ordinaryhasproperty
+func OrdinaryHasProperty(o Object, name string) bool {
+	return ordinaryHasProperty(o, StrKey(name))
+}
+
+func ordinaryHasProperty(o Object, key PropertyKey) bool {
+	if _, ok := key.GetOwnProperty(o); ok {
+		return true
+	}
+
+	parent := o.GetPrototypeOf()
+	if parent == nil {
+		return false
+	}
+
+	return key.HasProperty(parent)
+}
+
+// https://tc39.es/ecma262/#sec-ordinaryget
+func OrdinaryGet(o Object, name string, receiver Value) Value {
+	return ordinaryGet(o, StrKey(name), receiver)
+}
+
+func ordinaryGet(o Object, key PropertyKey, receiver Value) Value {
+	desc, ok := key.GetOwnProperty(o)
+	if !ok {
+		parent := o.GetPrototypeOf()
+		if parent == nil {
+			return NewUndefined()
+		}
+
+		return key.Get(parent, receiver)
+	}
+
+	if desc.IsDataDescriptor() {
+		return desc.Value
+	}
+
+	getter, ok := desc.Getter.(Callable)
+	if !ok {
+		return NewUndefined()
+	}
+
+	return getter.Call(receiver, nil)
+}
+
+// https://tc39.es/ecma262/#sec-ordinaryset
+func OrdinarySet(o Object, name string, value Value, receiver Value) bool {
+	return ordinarySet(o, StrKey(name), value, receiver)
+}
+
+func ordinarySet(o Object, key PropertyKey, value Value, receiver Value) bool {
+	ownDesc, ok := key.GetOwnProperty(o)
+	if !ok {
+		parent := o.GetPrototypeOf()
+		if parent != nil {
+			return key.Set(parent, value, receiver)
+		}
+
+		ownDesc = NewDataDescriptor(NewUndefined(), true, true, true)
+	}
+
+	if ownDesc.IsDataDescriptor() {
+		if !ownDesc.Writable {
+			return false
+		}
+
+		if receiver.Type != ValueTypeObj {
+			return false
+		}
+
+		existing, ok := key.GetOwnProperty(receiver.Obj)
+		if ok {
+			if existing.IsAccessorDescriptor() || !existing.Writable {
+				return false
+			}
+
+			return key.DefineOwnProperty(receiver.Obj, PropertyDescriptor{Value: value, HasValue: true})
+		}
+
+		return CreateDataProperty(receiver.Obj, key, value)
+	}
+
+	setter, ok := ownDesc.Setter.(Callable)
+	if !ok {
+		return false
+	}
+
+	setter.Call(receiver, []Value{value})
+	return true
+}
+
+// https://tc39.es/ecma262/#sec-set-o-p-v-throw
+func Set(o Object, key PropertyKey, value Value, throw bool) {
+	if !key.Set(o, value, NewObj(o)) && throw {
+		ThrowTypeError("Cannot assign to read only property '%s'", key.String())
+	}
+}
+
+// https://tc39.es/ecma262/#sec-getv
+func (r *Realm) GetV(v Value, key PropertyKey) Value {
+	if v.Type == ValueTypeStr {
+		if value, ok := stringProperty(v, key); ok {
+			return value
+		}
+	}
+	return key.Get(r.ToObject(v), v)
+}
+
+// https://tc39.es/ecma262/#sec-getmethod
+func (r *Realm) GetMethod(v Value, key PropertyKey) Value {
+	f := r.GetV(v, key)
+	if f.Type == ValueTypeUndefined || f.Type == ValueTypeNull {
+		return NewUndefined()
+	}
+
+	if !IsCallable(f) {
+		ThrowTypeError("%s is not a function", key.String())
+	}
+	return f
+}
+
+// https://tc39.es/ecma262/#sec-invoke
+func (r *Realm) Invoke(v Value, key PropertyKey, args ...Value) Value {
+	return Call(r.GetV(v, key), v, args...)
+}
+
+// https://tc39.es/ecma262/#sec-createdataproperty
+func CreateDataProperty(o Object, key PropertyKey, value Value) bool {
+	return key.DefineOwnProperty(o, NewDataDescriptor(value, true, true, true))
+}
+
+// https://tc39.es/ecma262/#sec-createdatapropertyorthrow
+func CreateDataPropertyOrThrow(o Object, key PropertyKey, value Value) {
+	if !CreateDataProperty(o, key, value) {
+		ThrowTypeError("Cannot define property %s", key.String())
+	}
+}
+
+// https://tc39.es/ecma262/#sec-definepropertyorthrow
+func DefinePropertyOrThrow(o Object, key PropertyKey, desc PropertyDescriptor) {
+	if !key.DefineOwnProperty(o, desc) {
+		ThrowTypeError("Cannot redefine property: %s", key.String())
+	}
+}
+
+// https://tc39.es/ecma262/#sec-speciesconstructor
+func SpeciesConstructor(o Object, defaultConstructor Value) Value {
+	c := o.Get("constructor", NewObj(o))
+	if c.Type == ValueTypeUndefined {
+		return defaultConstructor
+	}
+
+	if c.Type != ValueTypeObj {
+		ThrowTypeError("object.constructor is not an object")
+	}
+
+	s := SymbolKey(SymbolSpecies).Get(c.Obj, c)
+	if s.Type == ValueTypeUndefined || s.Type == ValueTypeNull {
+		return defaultConstructor
+	}
+
+	if !IsConstructor(s) {
+		ThrowTypeError("object.constructor[Symbol.species] is not a constructor")
+	}
+	return s
+}
+
+// https://tc39.es/ecma262/#sec-lengthofarraylike
+func LengthOfArrayLike(o Object) int64 {
+	return ToLength(o.Get("length", NewObj(o)))
+}
+
+// https://tc39.es/ecma262/#sec-copydataproperties
+func (r *Realm) CopyDataProperties(target Object, source Value, excluded []PropertyKey) {
+	if source.Type == ValueTypeUndefined || source.Type == ValueTypeNull {
+		return
+	}
+
+	from := r.ToObject(source)
+next:
+	for _, key := range OwnPropertyKeys(from) {
+		for _, e := range excluded {
+			if key == e {
+				continue next
+			}
+		}
+
+		if desc, ok := key.GetOwnProperty(from); ok && desc.Enumerable {
+			CreateDataPropertyOrThrow(target, key, key.Get(from, NewObj(from)))
+		}
+	}
+}
+
+type IntegrityLevel int
+
+const (
+	IntegrityLevelSealed IntegrityLevel = iota
+	IntegrityLevelFrozen
+)
+
+// https://tc39.es/ecma262/#sec-setintegritylevel
+func SetIntegrityLevel(o Object, level IntegrityLevel) bool {
+	if !o.PreventExtensions() {
+		return false
+	}
+
+	for _, key := range OwnPropertyKeys(o) {
+		desc := PropertyDescriptor{Configurable: false, HasConfigurable: true}
+		if level == IntegrityLevelFrozen {
+			current, ok := key.GetOwnProperty(o)
+			if !ok {
+				continue
+			}
+
+			if current.IsDataDescriptor() {
+				desc.Writable, desc.HasWritable = false, true
+			}
+		}
+		DefinePropertyOrThrow(o, key, desc)
+	}
+	return true
+}
+
+// https://tc39.es/ecma262/#sec-object.prototype.tostring
+func objectPrototypeToString(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		if this.Type == ValueTypeUndefined {
+			return NewStr("[object Undefined]")
+		}
+
+		if this.Type == ValueTypeNull {
+			return NewStr("[object Null]")
+		}
+
+		o := r.ToObject(this)
+		builtinTag := "Object"
+		switch o.(type) {
+		case *Array:
+			builtinTag = "Array"
+		case *BooleanObject:
+			builtinTag = "Boolean"
+		case *NumberObject:
+			builtinTag = "Number"
+		case *StringObject:
+			builtinTag = "String"
+		case *RegExpObject:
+			builtinTag = "RegExp"
+		case *ArgumentsObject:
+			builtinTag = "Arguments"
+		case Callable:
+			builtinTag = "Function"
+		}
+
+		tag := SymbolKey(SymbolToStringTag).Get(o, NewObj(o))
+		if tag.Type != ValueTypeStr {
+			return NewStr("[object " + builtinTag + "]")
+		}
+		return NewStr("[object " + tag.Str + "]")
+	}
+}
+
+// https://tc39.es/ecma262/#sec-object.prototype.valueof
+func objectPrototypeValueOf(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		return NewObj(r.ToObject(this))
+	}
+}
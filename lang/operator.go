@@ -0,0 +1,228 @@
+package lang
+
+import (
+	"math"
+	"math/big"
+)
+
+// https://tc39.es/ecma262/#sec-typeof-operator
+func TypeOf(v Value) string {
+	switch v.Type {
+	case ValueTypeUndefined:
+		return "undefined"
+	case ValueTypeNull:
+		return "object"
+	case ValueTypeBool:
+		return "boolean"
+	case ValueTypeNumber:
+		return "number"
+	case ValueTypeStr:
+		return "string"
+	case ValueTypeSymbol:
+		return "symbol"
+	case ValueTypeBigInt:
+		return "bigint"
+	default:
+		if IsCallable(v) {
+			return "function"
+		}
+		return "object"
+	}
+}
+
+// https://tc39.es/ecma262/#sec-isstrictlyequal
+func IsStrictlyEqual(x, y Value) bool {
+	if x.Type != y.Type {
+		return false
+	}
+
+	if x.Type == ValueTypeNumber {
+		return x.Number == y.Number
+	}
+
+	return SameValue(x, y)
+}
+
+// https://tc39.es/ecma262/#sec-islooselyequal
+func IsLooselyEqual(x, y Value) bool {
+	if x.Type == y.Type {
+		return IsStrictlyEqual(x, y)
+	}
+
+	isNullish := func(v Value) bool {
+		return v.Type == ValueTypeNull || v.Type == ValueTypeUndefined
+	}
+
+	switch {
+	case isNullish(x) && isNullish(y):
+		return true
+	case x.Type == ValueTypeNumber && y.Type == ValueTypeStr:
+		return IsLooselyEqual(x, NewNumber(ToNumber(y)))
+	case x.Type == ValueTypeStr && y.Type == ValueTypeNumber:
+		return IsLooselyEqual(NewNumber(ToNumber(x)), y)
+	case x.Type == ValueTypeBigInt && y.Type == ValueTypeStr:
+		n, ok := StringToBigInt(y.Str)
+		return ok && x.BigInt.Cmp(n) == 0
+	case x.Type == ValueTypeStr && y.Type == ValueTypeBigInt:
+		return IsLooselyEqual(y, x)
+	case x.Type == ValueTypeBool:
+		return IsLooselyEqual(NewNumber(ToNumber(x)), y)
+	case y.Type == ValueTypeBool:
+		return IsLooselyEqual(x, NewNumber(ToNumber(y)))
+	case isPrimitiveComparable(x) && y.Type == ValueTypeObj:
+		return IsLooselyEqual(x, ToPrimitive(y, HintDefault))
+	case x.Type == ValueTypeObj && isPrimitiveComparable(y):
+		return IsLooselyEqual(ToPrimitive(x, HintDefault), y)
+	case x.Type == ValueTypeBigInt && y.Type == ValueTypeNumber:
+		return !math.IsNaN(y.Number) && compareBigIntNumber(x.BigInt, y.Number) == 0
+	case x.Type == ValueTypeNumber && y.Type == ValueTypeBigInt:
+		return !math.IsNaN(x.Number) && compareBigIntNumber(y.BigInt, x.Number) == 0
+	default:
+		return false
+	}
+}
+
+// isPrimitiveComparable reports whether the value is loosely equal to an
+// object converted to a primitive.
+func isPrimitiveComparable(v Value) bool {
+	switch v.Type {
+	case ValueTypeNumber, ValueTypeStr, ValueTypeBigInt, ValueTypeSymbol:
+		return true
+	default:
+		return false
+	}
+}
+
+// https://tc39.es/ecma262/#sec-samevaluezero
+func SameValueZero(x, y Value) bool {
+	if x.Type == ValueTypeNumber && y.Type == ValueTypeNumber && x.Number == 0 && y.Number == 0 {
+		return true
+	}
+
+	return SameValue(x, y)
+}
+
+// https://tc39.es/ecma262/#sec-applystringornumericbinaryoperator
+func ApplyStringOrNumericBinaryOperator(l Value, operator string, r Value) Value {
+	if operator == "+" {
+		lprim := ToPrimitive(l, HintDefault)
+		rprim := ToPrimitive(r, HintDefault)
+		if lprim.Type == ValueTypeStr || rprim.Type == ValueTypeStr {
+			return NewStr(ConcatStrings(ToString(lprim), ToString(rprim)))
+		}
+		l, r = lprim, rprim
+	}
+
+	lval, rval := ToNumeric(l), ToNumeric(r)
+	if lval.Type != rval.Type {
+		ThrowTypeError("Cannot mix BigInt and other types, use explicit conversions")
+	}
+
+	if lval.Type == ValueTypeBigInt {
+		return NewBigInt(bigIntBinaryOperator(lval.BigInt, operator, rval.BigInt))
+	}
+
+	lnum, rnum := lval.Number, rval.Number
+	switch operator {
+	case "+":
+		return NewNumber(lnum + rnum)
+	case "-":
+		return NewNumber(lnum - rnum)
+	case "*":
+		return NewNumber(lnum * rnum)
+	case "/":
+		return NewNumber(lnum / rnum)
+	case "%":
+		return NewNumber(math.Mod(lnum, rnum))
+	case "**":
+		return NewNumber(numberExponentiate(lnum, rnum))
+	}
+
+	switch operator {
+	case "<<":
+		return NewNumber(float64(ToInt32(lval) << (ToUint32(rval) & 0x1F)))
+	case ">>":
+		return NewNumber(float64(ToInt32(lval) >> (ToUint32(rval) & 0x1F)))
+	case ">>>":
+		return NewNumber(float64(ToUint32(lval) >> (ToUint32(rval) & 0x1F)))
+	case "&":
+		return NewNumber(float64(ToInt32(lval) & ToInt32(rval)))
+	case "|":
+		return NewNumber(float64(ToInt32(lval) | ToInt32(rval)))
+	case "^":
+		return NewNumber(float64(ToInt32(lval) ^ ToInt32(rval)))
+	}
+
+	panic("unsupported operator: " + operator)
+}
+
+// https://tc39.es/ecma262/#sec-unary-minus-operator-runtime-semantics-evaluation
+func UnaryMinus(v Value) Value {
+	n := ToNumeric(v)
+	if n.Type == ValueTypeBigInt {
+		return NewBigInt(new(big.Int).Neg(n.BigInt))
+	}
+	return NewNumber(-n.Number)
+}
+
+// https://tc39.es/ecma262/#sec-bitwise-not-operator-runtime-semantics-evaluation
+func BitwiseNot(v Value) Value {
+	n := ToNumeric(v)
+	if n.Type == ValueTypeBigInt {
+		return NewBigInt(new(big.Int).Not(n.BigInt))
+	}
+	return NewNumber(float64(^ToInt32(n)))
+}
+
+// https://tc39.es/ecma262/#sec-numeric-types-number-exponentiate
+func numberExponentiate(base, exponent float64) float64 {
+	if math.IsNaN(exponent) {
+		return math.NaN()
+	}
+
+	if math.Abs(base) == 1 && math.IsInf(exponent, 0) {
+		return math.NaN()
+	}
+
+	return math.Pow(base, exponent)
+}
+
+// https://tc39.es/ecma262/#sec-instanceofoperator
+func InstanceofOperator(v, target Value) bool {
+	if target.Type != ValueTypeObj {
+		ThrowTypeError("Right-hand side of 'instanceof' is not an object")
+	}
+
+	instOfHandler := SymbolKey(SymbolHasInstance).Get(target.Obj, target)
+	if instOfHandler.Type != ValueTypeUndefined && instOfHandler.Type != ValueTypeNull {
+		if !IsCallable(instOfHandler) {
+			ThrowTypeError("Symbol.hasInstance is not a function")
+		}
+		return ToBoolean(Call(instOfHandler, target, v))
+	}
+
+	if !IsCallable(target) {
+		ThrowTypeError("Right-hand side of 'instanceof' is not callable")
+	}
+
+	return OrdinaryHasInstance(target, v)
+}
+
+// https://tc39.es/ecma262/#sec-ordinaryhasinstance
+func OrdinaryHasInstance(c, o Value) bool {
+	if !IsCallable(c) || o.Type != ValueTypeObj {
+		return false
+	}
+
+	p := c.Obj.Get("prototype", c)
+	if p.Type != ValueTypeObj {
+		ThrowTypeError("Function has non-object prototype in instanceof check")
+	}
+
+	for proto := o.Obj.GetPrototypeOf(); proto != nil; proto = proto.GetPrototypeOf() {
+		if proto == p.Obj {
+			return true
+		}
+	}
+	return false
+}
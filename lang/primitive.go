@@ -0,0 +1,213 @@
+package lang
+
+import (
+	"strconv"
+)
+
+// https://tc39.es/ecma262/#sec-properties-of-boolean-instances
+type BooleanObject struct {
+	JsObject
+	BooleanData bool
+}
+
+func (r *Realm) NewBooleanObject(b bool) *BooleanObject {
+	o := &BooleanObject{BooleanData: b}
+	o.init(r.BooleanPrototype)
+	return o
+}
+
+// https://tc39.es/ecma262/#sec-thisbooleanvalue
+func thisBooleanValue(v Value) bool {
+	if v.Type == ValueTypeBool {
+		return v.Bool
+	}
+
+	if o, ok := v.Obj.(*BooleanObject); ok && v.Type == ValueTypeObj {
+		return o.BooleanData
+	}
+
+	ThrowTypeError("Boolean.prototype method called on incompatible receiver")
+	return false
+}
+
+// https://tc39.es/ecma262/#sec-boolean.prototype.tostring
+func booleanPrototypeToString(this Value, args []Value) Value {
+	if thisBooleanValue(this) {
+		return NewStr("true")
+	}
+	return NewStr("false")
+}
+
+// https://tc39.es/ecma262/#sec-boolean.prototype.valueof
+func booleanPrototypeValueOf(this Value, args []Value) Value {
+	return NewBool(thisBooleanValue(this))
+}
+
+// https://tc39.es/ecma262/#sec-properties-of-number-instances
+type NumberObject struct {
+	JsObject
+	NumberData float64
+}
+
+func (r *Realm) NewNumberObject(n float64) *NumberObject {
+	o := &NumberObject{NumberData: n}
+	o.init(r.NumberPrototype)
+	return o
+}
+
+// https://tc39.es/ecma262/#sec-thisnumbervalue
+func thisNumberValue(v Value) float64 {
+	if v.Type == ValueTypeNumber {
+		return v.Number
+	}
+
+	if o, ok := v.Obj.(*NumberObject); ok && v.Type == ValueTypeObj {
+		return o.NumberData
+	}
+
+	ThrowTypeError("Number.prototype method called on incompatible receiver")
+	return 0
+}
+
+// https://tc39.es/ecma262/#sec-number.prototype.tostring
+func numberPrototypeToString(this Value, args []Value) Value {
+	x := thisNumberValue(this)
+
+	radix := 10.0
+	if len(args) > 0 && args[0].Type != ValueTypeUndefined {
+		radix = ToIntegerOrInfinity(args[0])
+	}
+
+	if radix < 2 || radix > 36 {
+		ThrowRangeError("toString() radix must be between 2 and 36")
+	}
+
+	return NewStr(NumberToString(x, int(radix)))
+}
+
+// https://tc39.es/ecma262/#sec-number.prototype.valueof
+func numberPrototypeValueOf(this Value, args []Value) Value {
+	return NewNumber(thisNumberValue(this))
+}
+
+// StringObject is a String exotic object.
+// https://tc39.es/ecma262/#sec-string-exotic-objects
+type StringObject struct {
+	JsObject
+	StringData string
+
+	// value is the String value of StringData, which caches its measurements.
+	value Value
+}
+
+// https://tc39.es/ecma262/#sec-stringcreate
+func (r *Realm) NewStringObject(s string) *StringObject {
+	return r.newStringObject(NewStr(s))
+}
+
+func (r *Realm) newStringObject(v Value) *StringObject {
+	o := &StringObject{StringData: v.Str, value: v}
+	o.init(r.StringPrototype)
+	o.JsObject.DefineOwnProperty("length", NewDataDescriptor(NewInt(v.stringLength()), false, false, false))
+	return o
+}
+
+// https://tc39.es/ecma262/#sec-string-exotic-objects-getownproperty-p
+func (s *StringObject) GetOwnProperty(name string) (PropertyDescriptor, bool) {
+	if desc, ok := s.JsObject.GetOwnProperty(name); ok {
+		return desc, true
+	}
+
+	return s.stringGetOwnProperty(name)
+}
+
+// https://tc39.es/ecma262/#sec-string-exotic-objects-defineownproperty-p-desc
+func (s *StringObject) DefineOwnProperty(name string, desc PropertyDescriptor) bool {
+	if current, ok := s.stringGetOwnProperty(name); ok {
+		return IsCompatiblePropertyDescriptor(s.extensible, desc, current, true)
+	}
+
+	return s.JsObject.DefineOwnProperty(name, desc)
+}
+
+func (s *StringObject) HasProperty(name string) bool {
+	return OrdinaryHasProperty(s, name)
+}
+
+func (s *StringObject) Get(name string, receiver Value) Value {
+	return OrdinaryGet(s, name, receiver)
+}
+
+func (s *StringObject) Set(name string, value Value, receiver Value) bool {
+	return OrdinarySet(s, name, value, receiver)
+}
+
+func (s *StringObject) Delete(name string) bool {
+	if _, ok := s.stringGetOwnProperty(name); ok {
+		return false
+	}
+
+	return s.JsObject.Delete(name)
+}
+
+// https://tc39.es/ecma262/#sec-string-exotic-objects-ownpropertykeys
+func (s *StringObject) OwnPropertyKeys() []string {
+	length := s.value.stringLength()
+	keys := make([]string, 0, length+len(s.keys))
+	for idx := 0; idx < length; idx++ {
+		keys = append(keys, strconv.Itoa(idx))
+	}
+
+	for _, key := range s.JsObject.OwnPropertyKeys() {
+		if idx, ok := IsArrayIndex(key); !ok || int(idx) >= length {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// https://tc39.es/ecma262/#sec-stringgetownproperty
+func (s *StringObject) stringGetOwnProperty(name string) (PropertyDescriptor, bool) {
+	idx, ok := IsArrayIndex(name)
+	if !ok || int(idx) >= s.value.stringLength() {
+		return PropertyDescriptor{}, false
+	}
+
+	return NewDataDescriptor(NewStr(s.value.codeUnitAt(int(idx))), false, true, false), true
+}
+
+// stringProperty reads the length or a code unit of a String value, own
+// properties of its wrapper object, without creating the wrapper.
+func stringProperty(v Value, key PropertyKey) (Value, bool) {
+	if key.Symbol != nil {
+		return Value{}, false
+	}
+
+	if key.Str == "length" {
+		return NewInt(v.stringLength()), true
+	}
+
+	if idx, ok := IsArrayIndex(key.Str); ok && int(idx) < v.stringLength() {
+		return NewStr(v.codeUnitAt(int(idx))), true
+	}
+	return Value{}, false
+}
+
+// https://tc39.es/ecma262/#sec-thisstringvalue
+func thisStringValue(v Value) string {
+	if v.Type == ValueTypeStr {
+		return v.Str
+	}
+
+	if o, ok := v.Obj.(*StringObject); ok && v.Type == ValueTypeObj {
+		return o.StringData
+	}
+
+	ThrowTypeError("String.prototype method called on incompatible receiver")
+	return ""
+}
+
+// https://tc39.es/ecma262/#sec-string.prototype.tostring
+func stringPrototypeToString(this Value, args []Value) Value {
+	return NewStr(thisStringValue(this))
+}
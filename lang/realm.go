@@ -0,0 +1,205 @@
+package lang
+
+import (
+	"gojs/ast"
+	"math"
+)
+
+// https://tc39.es/ecma262/#sec-code-realms
+type Realm struct {
+	Evaluator Evaluator
+
+	ObjectPrototype   *JsObject
+	FunctionPrototype *NativeFunction
+	ArrayPrototype    *Array
+	BooleanPrototype  *BooleanObject
+	NumberPrototype   *NumberObject
+	StringPrototype   *StringObject
+	RegExpPrototype   *JsObject
+	SymbolPrototype   *JsObject
+	BigIntPrototype   *JsObject
+
+	// https://tc39.es/ecma262/#sec-%iteratorprototype%-object
+	IteratorPrototype *JsObject
+
+	// https://tc39.es/ecma262/#sec-%arrayiteratorprototype%-object
+	ArrayIteratorPrototype *JsObject
+
+	// https://tc39.es/ecma262/#sec-%stringiteratorprototype%-object
+	StringIteratorPrototype *JsObject
+
+	// https://tc39.es/ecma262/#sec-%regexpstringiteratorprototype%-object
+	RegExpStringIteratorPrototype *JsObject
+
+	// https://tc39.es/ecma262/#sec-properties-of-the-generatorfunction-prototype-object
+	GeneratorFunctionPrototype *JsObject
+
+	// https://tc39.es/ecma262/#sec-properties-of-generator-prototype
+	GeneratorPrototype *JsObject
+
+	MapPrototype *JsObject
+	SetPrototype *JsObject
+
+	// https://tc39.es/ecma262/#sec-%mapiteratorprototype%-object
+	MapIteratorPrototype *JsObject
+
+	// https://tc39.es/ecma262/#sec-%setiteratorprototype%-object
+	SetIteratorPrototype *JsObject
+
+	// https://tc39.es/ecma262/#sec-%throwtypeerror%
+	ThrowTypeErrorFunction *NativeFunction
+
+	// https://tc39.es/ecma262/#sec-global-object
+	GlobalObject *JsObject
+
+	templateMap map[*ast.TemplateLiteral]*Array
+
+	// regExpPrototypeExec is %RegExp.prototype.exec%, which RegExpExec runs
+	// without converting the string again.
+	regExpPrototypeExec *NativeFunction
+}
+
+// https://tc39.es/ecma262/#sec-createintrinsics
+func NewRealm() *Realm {
+	r := &Realm{templateMap: make(map[*ast.TemplateLiteral]*Array)}
+
+	r.ObjectPrototype = NewJsObject(nil)
+
+	// https://tc39.es/ecma262/#sec-properties-of-the-function-prototype-object
+	r.FunctionPrototype = r.NewNativeFunction("", 0, func(this Value, args []Value) Value {
+		return NewUndefined()
+	})
+	r.FunctionPrototype.SetPrototypeOf(r.ObjectPrototype)
+	r.defineBuiltinMethod(r.FunctionPrototype, "toString", 0, functionPrototypeToString)
+	hasInstance := r.NewNativeFunction(SymbolKey(SymbolHasInstance).FunctionName(), 1, functionPrototypeHasInstance)
+	SymbolKey(SymbolHasInstance).DefineOwnProperty(r.FunctionPrototype, NewDataDescriptor(NewObj(hasInstance), false, false, false))
+
+	r.ThrowTypeErrorFunction = r.NewNativeFunction("", 0, func(this Value, args []Value) Value {
+		ThrowTypeError("'caller', 'callee', and 'arguments' properties may not be accessed on strict mode functions or the arguments objects for calls to them")
+		return NewUndefined()
+	})
+	r.ThrowTypeErrorFunction.DefineOwnProperty("length", NewDataDescriptor(NewInt(0), false, false, false))
+	r.ThrowTypeErrorFunction.DefineOwnProperty("name", NewDataDescriptor(NewStr(""), false, false, false))
+	r.ThrowTypeErrorFunction.PreventExtensions()
+
+	r.defineBuiltinMethod(r.ObjectPrototype, "toString", 0, objectPrototypeToString(r))
+	r.defineBuiltinMethod(r.ObjectPrototype, "valueOf", 0, objectPrototypeValueOf(r))
+
+	r.IteratorPrototype = r.NewObject()
+	r.defineBuiltinSymbolMethod(r.IteratorPrototype, SymbolIterator, 0, iteratorPrototypeIterator)
+
+	r.ArrayPrototype = NewArray(r.ObjectPrototype, nil)
+	r.defineBuiltinMethod(r.ArrayPrototype, "concat", 1, arrayPrototypeConcat(r))
+	r.defineBuiltinMethod(r.ArrayPrototype, "join", 1, arrayPrototypeJoin(r))
+	r.defineBuiltinMethod(r.ArrayPrototype, "toString", 0, arrayPrototypeToString(r))
+	r.defineBuiltinMethod(r.ArrayPrototype, "keys", 0, arrayPrototypeIterator(r, arrayIteratorKindKey))
+	r.defineBuiltinMethod(r.ArrayPrototype, "entries", 0, arrayPrototypeIterator(r, arrayIteratorKindKeyValue))
+	values := r.defineBuiltinMethod(r.ArrayPrototype, "values", 0, arrayPrototypeIterator(r, arrayIteratorKindValue))
+	SymbolKey(SymbolIterator).DefineOwnProperty(r.ArrayPrototype, NewDataDescriptor(NewObj(values), true, false, true))
+	SymbolKey(SymbolUnscopables).DefineOwnProperty(r.ArrayPrototype, NewDataDescriptor(NewObj(arrayUnscopables()), false, false, true))
+
+	r.ArrayIteratorPrototype = NewJsObject(r.IteratorPrototype)
+	r.defineBuiltinMethod(r.ArrayIteratorPrototype, "next", 0, arrayIteratorNext(r))
+	r.defineToStringTag(r.ArrayIteratorPrototype, "Array Iterator")
+
+	// https://tc39.es/ecma262/#sec-properties-of-the-boolean-prototype-object
+	r.BooleanPrototype = r.NewBooleanObject(false)
+	r.BooleanPrototype.SetPrototypeOf(r.ObjectPrototype)
+	r.defineBuiltinMethod(r.BooleanPrototype, "toString", 0, booleanPrototypeToString)
+	r.defineBuiltinMethod(r.BooleanPrototype, "valueOf", 0, booleanPrototypeValueOf)
+
+	// https://tc39.es/ecma262/#sec-properties-of-the-number-prototype-object
+	r.NumberPrototype = r.NewNumberObject(0)
+	r.NumberPrototype.SetPrototypeOf(r.ObjectPrototype)
+	r.defineBuiltinMethod(r.NumberPrototype, "toString", 1, numberPrototypeToString)
+	r.defineBuiltinMethod(r.NumberPrototype, "valueOf", 0, numberPrototypeValueOf)
+
+	// https://tc39.es/ecma262/#sec-properties-of-the-string-prototype-object
+	r.StringPrototype = r.NewStringObject("")
+	r.StringPrototype.SetPrototypeOf(r.ObjectPrototype)
+	r.defineBuiltinMethod(r.StringPrototype, "toString", 0, stringPrototypeToString)
+	r.defineBuiltinMethod(r.StringPrototype, "valueOf", 0, stringPrototypeToString)
+	r.defineBuiltinMethod(r.StringPrototype, "match", 1, stringPrototypeMatch(r))
+	r.defineBuiltinMethod(r.StringPrototype, "matchAll", 1, stringPrototypeMatchAll(r))
+	r.defineBuiltinMethod(r.StringPrototype, "replace", 2, stringPrototypeReplace(r))
+	r.defineBuiltinMethod(r.StringPrototype, "search", 1, stringPrototypeSearch(r))
+	r.defineBuiltinMethod(r.StringPrototype, "split", 2, stringPrototypeSplit(r))
+	r.defineBuiltinSymbolMethod(r.StringPrototype, SymbolIterator, 0, stringPrototypeIterator(r))
+
+	r.StringIteratorPrototype = NewJsObject(r.IteratorPrototype)
+	r.defineBuiltinMethod(r.StringIteratorPrototype, "next", 0, stringIteratorNext(r))
+	r.defineToStringTag(r.StringIteratorPrototype, "String Iterator")
+
+	r.GlobalObject = r.NewObject()
+
+	// https://tc39.es/ecma262/#sec-value-properties-of-the-global-object
+	r.GlobalObject.DefineOwnProperty("Infinity", NewDataDescriptor(NewNumber(math.Inf(1)), false, false, false))
+	r.GlobalObject.DefineOwnProperty("NaN", NewDataDescriptor(NewNumber(math.NaN()), false, false, false))
+	r.GlobalObject.DefineOwnProperty("undefined", NewDataDescriptor(NewUndefined(), false, false, false))
+
+	// https://tc39.es/ecma262/#sec-string-constructor
+	stringCtor := r.defineBuiltinConstructor("String", 1, stringConstructor, r.StringPrototype)
+	stringCtor.Constructor = stringConstruct(r)
+	r.defineBuiltinMethod(stringCtor, "raw", 1, stringRaw(r))
+
+	r.initSymbol()
+	r.initBigInt()
+	r.initRegExp()
+	r.initGenerator()
+	r.initMap()
+	r.initSet()
+	return r
+}
+
+func (r *Realm) NewObject() *JsObject {
+	return NewJsObject(r.ObjectPrototype)
+}
+
+func (r *Realm) NewArray(values []Value) *Array {
+	return NewArray(r.ArrayPrototype, values)
+}
+
+// defineBuiltinConstructor defines a global constructor linked to its
+// prototype object.
+func (r *Realm) defineBuiltinConstructor(name string, length int, function func(this Value, args []Value) Value, prototype Object) *NativeFunction {
+	f := r.NewNativeFunction(name, length, function)
+	f.DefineOwnProperty("prototype", NewDataDescriptor(NewObj(prototype), false, false, false))
+	prototype.DefineOwnProperty("constructor", NewDataDescriptor(NewObj(f), true, false, true))
+	r.GlobalObject.DefineOwnProperty(name, NewDataDescriptor(NewObj(f), true, false, true))
+	return f
+}
+
+func (r *Realm) defineBuiltinMethod(o Object, name string, length int, function func(this Value, args []Value) Value) *NativeFunction {
+	f := r.NewNativeFunction(name, length, function)
+	o.DefineOwnProperty(name, NewDataDescriptor(NewObj(f), true, false, true))
+	return f
+}
+
+// defineBuiltinSymbolMethod defines a method keyed by a well-known symbol.
+func (r *Realm) defineBuiltinSymbolMethod(o Object, symbol *Symbol, length int, function func(this Value, args []Value) Value) *NativeFunction {
+	key := SymbolKey(symbol)
+	f := r.NewNativeFunction(key.FunctionName(), length, function)
+	key.DefineOwnProperty(o, NewDataDescriptor(NewObj(f), true, false, true))
+	return f
+}
+
+// defineBuiltinSpeciesGetter defines the @@species accessor of a
+// constructor, which returns the this value.
+// https://tc39.es/ecma262/#sec-get-map-@@species
+func (r *Realm) defineBuiltinSpeciesGetter(ctor Object) {
+	f := r.NewNativeFunction("get [Symbol.species]", 0, func(this Value, args []Value) Value {
+		return this
+	})
+	SymbolKey(SymbolSpecies).DefineOwnProperty(ctor, NewAccessorDescriptor(f, nil, false, true))
+}
+
+// https://tc39.es/ecma262/#sec-symbol.tostringtag
+func (r *Realm) defineToStringTag(o Object, tag string) {
+	SymbolKey(SymbolToStringTag).DefineOwnProperty(o, NewDataDescriptor(NewStr(tag), false, false, true))
+}
+
+// defineBuiltinGetter defines an accessor property without a setter.
+func (r *Realm) defineBuiltinGetter(o Object, name string, function func(this Value, args []Value) Value) {
+	f := r.NewNativeFunction("get "+name, 0, function)
+	o.DefineOwnProperty(name, NewAccessorDescriptor(f, nil, false, true))
+}
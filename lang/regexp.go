@@ -0,0 +1,738 @@
+package lang
+
+import (
+	"gojs/regex"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+)
+
+// regExpFlags lists the flag accessors in the order of the flags string.
+// https://tc39.es/ecma262/#sec-get-regexp.prototype.flags
+var regExpFlags = []struct {
+	name string
+	ch   byte
+}{
+	{"hasIndices", 'd'},
+	{"global", 'g'},
+	{"ignoreCase", 'i'},
+	{"multiline", 'm'},
+	{"dotAll", 's'},
+	{"unicode", 'u'},
+	{"unicodeSets", 'v'},
+	{"sticky", 'y'},
+}
+
+// RegExpObject is a regular expression instance.
+// https://tc39.es/ecma262/#sec-properties-of-regexp-instances
+type RegExpObject struct {
+	JsObject
+	OriginalSource string
+	OriginalFlags  string
+
+	matcher *regex.Regexp
+}
+
+// NewRegExpObject allocates and initializes a regular expression, throwing a
+// SyntaxError when the pattern or the flags are invalid.
+// https://tc39.es/ecma262/#sec-regexpcreate
+func (r *Realm) NewRegExpObject(pattern, flags string) *RegExpObject {
+	matcher, err := regex.Compile(pattern, flags)
+	if err != nil {
+		ThrowSyntaxError("%s", err.Error())
+	}
+
+	o := &RegExpObject{OriginalSource: pattern, OriginalFlags: flags, matcher: matcher}
+	o.init(r.RegExpPrototype)
+	o.JsObject.DefineOwnProperty("lastIndex", NewDataDescriptor(NewInt(0), true, false, false))
+	return o
+}
+
+// https://tc39.es/ecma262/#sec-regexp-constructor
+func (r *Realm) initRegExp() {
+	r.RegExpPrototype = r.NewObject()
+
+	var ctor *NativeFunction
+	ctor = r.defineBuiltinConstructor("RegExp", 2, func(this Value, args []Value) Value {
+		pattern, flags := argument(args, 0), argument(args, 1)
+		if r.isRegExp(pattern) && flags.Type == ValueTypeUndefined {
+			if SameValue(NewObj(ctor), pattern.Obj.Get("constructor", pattern)) {
+				return pattern
+			}
+		}
+		return NewObj(r.regExpCreateFrom(pattern, flags))
+	}, r.RegExpPrototype)
+	ctor.Constructor = func(args []Value, newTarget Object) Value {
+		o := r.regExpCreateFrom(argument(args, 0), argument(args, 1))
+		o.SetPrototypeOf(GetPrototypeFromConstructor(newTarget, r.RegExpPrototype))
+		return NewObj(o)
+	}
+
+	p := r.RegExpPrototype
+	r.regExpPrototypeExec = r.defineBuiltinMethod(p, "exec", 1, regExpPrototypeExec(r))
+	r.defineBuiltinMethod(p, "test", 1, regExpPrototypeTest(r))
+	r.defineBuiltinMethod(p, "toString", 0, regExpPrototypeToString)
+	r.defineBuiltinGetter(p, "flags", regExpPrototypeFlags)
+	r.defineBuiltinGetter(p, "source", regExpPrototypeSource(r))
+
+	for _, flag := range regExpFlags {
+		r.defineBuiltinGetter(p, flag.name, regExpHasFlag(r, flag.ch))
+	}
+
+	r.defineBuiltinSymbolMethod(p, SymbolMatch, 1, regExpPrototypeMatch(r))
+	r.defineBuiltinSymbolMethod(p, SymbolMatchAll, 1, regExpPrototypeMatchAll(r, ctor))
+	r.defineBuiltinSymbolMethod(p, SymbolReplace, 2, regExpPrototypeReplace(r))
+	r.defineBuiltinSymbolMethod(p, SymbolSearch, 1, regExpPrototypeSearch(r))
+	r.defineBuiltinSymbolMethod(p, SymbolSplit, 2, regExpPrototypeSplit(r, ctor))
+	r.defineBuiltinSpeciesGetter(ctor)
+
+	// https://tc39.es/ecma262/#sec-%regexpstringiteratorprototype%-object
+	r.RegExpStringIteratorPrototype = NewJsObject(r.IteratorPrototype)
+	r.defineBuiltinMethod(r.RegExpStringIteratorPrototype, "next", 0, regExpStringIteratorNext(r))
+	r.defineToStringTag(r.RegExpStringIteratorPrototype, "RegExp String Iterator")
+}
+
+// regExpCreateFrom follows the RegExp constructor once it is known that a new
+// object has to be created.
+// https://tc39.es/ecma262/#sec-regexp-pattern-flags
+func (r *Realm) regExpCreateFrom(pattern, flags Value) *RegExpObject {
+	var p, f Value
+	if o, ok := pattern.Obj.(*RegExpObject); ok && pattern.Type == ValueTypeObj {
+		p, f = NewStr(o.OriginalSource), flags
+		if flags.Type == ValueTypeUndefined {
+			f = NewStr(o.OriginalFlags)
+		}
+	} else if r.isRegExp(pattern) {
+		p, f = pattern.Obj.Get("source", pattern), flags
+		if flags.Type == ValueTypeUndefined {
+			f = pattern.Obj.Get("flags", pattern)
+		}
+	} else {
+		p, f = pattern, flags
+	}
+	return r.RegExpCreate(p, f)
+}
+
+// https://tc39.es/ecma262/#sec-regexpcreate
+// https://tc39.es/ecma262/#sec-regexpinitialize
+func (r *Realm) RegExpCreate(pattern, flags Value) *RegExpObject {
+	p, f := "", ""
+	if pattern.Type != ValueTypeUndefined {
+		p = ToString(pattern)
+	}
+	if flags.Type != ValueTypeUndefined {
+		f = ToString(flags)
+	}
+	return r.NewRegExpObject(p, f)
+}
+
+// https://tc39.es/ecma262/#sec-isregexp
+func (r *Realm) isRegExp(v Value) bool {
+	if v.Type != ValueTypeObj {
+		return false
+	}
+
+	if matcher := SymbolKey(SymbolMatch).Get(v.Obj, v); matcher.Type != ValueTypeUndefined {
+		return ToBoolean(matcher)
+	}
+
+	_, ok := v.Obj.(*RegExpObject)
+	return ok
+}
+
+func argument(args []Value, idx int) Value {
+	if idx < len(args) {
+		return args[idx]
+	}
+	return NewUndefined()
+}
+
+func thisRegExpObject(this Value, method string) *RegExpObject {
+	if o, ok := this.Obj.(*RegExpObject); ok && this.Type == ValueTypeObj {
+		return o
+	}
+
+	ThrowTypeError("RegExp.prototype.%s called on incompatible receiver", method)
+	return nil
+}
+
+func thisObject(this Value, method string) Object {
+	if this.Type != ValueTypeObj {
+		ThrowTypeError("%s called on non-object", method)
+	}
+	return this.Obj
+}
+
+// https://tc39.es/ecma262/#sec-regexp.prototype.exec
+func regExpPrototypeExec(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		o := thisRegExpObject(this, "exec")
+		s := ToString(argument(args, 0))
+		return r.regExpBuiltinExec(o, s, toUTF16(s))
+	}
+}
+
+// https://tc39.es/ecma262/#sec-regexp.prototype.test
+func regExpPrototypeTest(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		o := thisObject(this, "RegExp.prototype.test")
+		return NewBool(r.RegExpExec(o, ToString(argument(args, 0))).Type != ValueTypeNull)
+	}
+}
+
+// https://tc39.es/ecma262/#sec-regexp.prototype.tostring
+func regExpPrototypeToString(this Value, args []Value) Value {
+	o := thisObject(this, "RegExp.prototype.toString")
+	return NewStr("/" + ToString(o.Get("source", this)) + "/" + ToString(o.Get("flags", this)))
+}
+
+// https://tc39.es/ecma262/#sec-get-regexp.prototype.flags
+func regExpPrototypeFlags(this Value, args []Value) Value {
+	o := thisObject(this, "RegExp.prototype.flags getter")
+
+	var b strings.Builder
+	for _, flag := range regExpFlags {
+		if ToBoolean(o.Get(flag.name, this)) {
+			b.WriteByte(flag.ch)
+		}
+	}
+	return NewStr(b.String())
+}
+
+// https://tc39.es/ecma262/#sec-get-regexp.prototype.source
+func regExpPrototypeSource(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		thisObject(this, "RegExp.prototype.source getter")
+		if this.Obj == Object(r.RegExpPrototype) {
+			return NewStr("(?:)")
+		}
+
+		o := thisRegExpObject(this, "source getter")
+		return NewStr(escapeRegExpPattern(o.OriginalSource))
+	}
+}
+
+// https://tc39.es/ecma262/#sec-regexphasflag
+func regExpHasFlag(r *Realm, flag byte) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		thisObject(this, "RegExp.prototype flag getter")
+		if this.Obj == Object(r.RegExpPrototype) {
+			return NewUndefined()
+		}
+
+		o := thisRegExpObject(this, "flag getter")
+		return NewBool(strings.IndexByte(o.OriginalFlags, flag) >= 0)
+	}
+}
+
+// escapeRegExpPattern escapes the source so that it can be placed between
+// slashes and parsed back as the same regular expression literal.
+// https://tc39.es/ecma262/#sec-escaperegexppattern
+func escapeRegExpPattern(source string) string {
+	if source == "" {
+		return "(?:)"
+	}
+
+	var b strings.Builder
+	escaped, class := false, false
+	for _, ch := range source {
+		switch {
+		case escaped:
+			escaped = false
+		case ch == '\\':
+			escaped = true
+		case ch == '[':
+			class = true
+		case ch == ']':
+			class = false
+		case ch == '/' && !class:
+			b.WriteString("\\/")
+			continue
+		}
+
+		switch ch {
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\u2028':
+			b.WriteString(`\u2028`)
+		case '\u2029':
+			b.WriteString(`\u2029`)
+		default:
+			b.WriteRune(ch)
+		}
+	}
+	return b.String()
+}
+
+// https://tc39.es/ecma262/#sec-regexpexec
+func (r *Realm) RegExpExec(o Object, s string) Value {
+	return r.regExpExec(o, s, toUTF16(s))
+}
+
+// regExpExec is RegExpExec with the code units of the string, which the
+// methods that match repeatedly convert once.
+func (r *Realm) regExpExec(o Object, s string, input []uint16) Value {
+	exec := o.Get("exec", NewObj(o))
+	if rx, ok := o.(*RegExpObject); ok && exec.Type == ValueTypeObj && exec.Obj == Object(r.regExpPrototypeExec) {
+		return r.regExpBuiltinExec(rx, s, input)
+	}
+	if IsCallable(exec) {
+		result := Call(exec, NewObj(o), NewStr(s))
+		if result.Type != ValueTypeObj && result.Type != ValueTypeNull {
+			ThrowTypeError("exec result must be an object or null")
+		}
+		return result
+	}
+
+	return r.regExpBuiltinExec(thisRegExpObject(NewObj(o), "exec"), s, input)
+}
+
+// https://tc39.es/ecma262/#sec-regexpbuiltinexec
+func (r *Realm) regExpBuiltinExec(o *RegExpObject, s string, input []uint16) Value {
+	flags := o.matcher.Flags
+
+	lastIndex := ToLength(o.Get("lastIndex", NewObj(o)))
+	if !flags.Global && !flags.Sticky {
+		lastIndex = 0
+	}
+
+	var caps []int
+	for {
+		if lastIndex > int64(len(input)) {
+			if flags.Global || flags.Sticky {
+				Set(o, StrKey("lastIndex"), NewInt(0), true)
+			}
+			return NewNull()
+		}
+
+		var err error
+		if caps, err = o.matcher.MatchAt(input, int(lastIndex)); err != nil {
+			ThrowRangeError("%s", err.Error())
+		}
+		if caps != nil {
+			break
+		}
+
+		if flags.Sticky {
+			Set(o, StrKey("lastIndex"), NewInt(0), true)
+			return NewNull()
+		}
+		lastIndex = advanceStringIndex(input, lastIndex, flags.Unicode || flags.UnicodeSets)
+	}
+
+	if flags.Global || flags.Sticky {
+		Set(o, StrKey("lastIndex"), NewInt(caps[1]), true)
+	}
+
+	a := r.NewArray(nil)
+	CreateDataPropertyOrThrow(a, StrKey("index"), NewInt(caps[0]))
+	CreateDataPropertyOrThrow(a, StrKey("input"), NewStr(s))
+
+	names := o.matcher.GroupNames()
+	groups, indexGroups := NewUndefined(), NewUndefined()
+	if o.matcher.HasNamedGroups() {
+		groups, indexGroups = NewObj(NewJsObject(nil)), NewObj(NewJsObject(nil))
+	}
+
+	indices := r.NewArray(nil)
+	for idx := range names {
+		value, pair := NewUndefined(), NewUndefined()
+		if start, end := caps[2*idx], caps[2*idx+1]; start >= 0 {
+			value = NewStr(fromUTF16(input[start:end]))
+			pair = NewObj(r.NewArray([]Value{NewInt(start), NewInt(end)}))
+		}
+
+		CreateDataPropertyOrThrow(a, StrKey(strconv.Itoa(idx)), value)
+		CreateDataPropertyOrThrow(indices, StrKey(strconv.Itoa(idx)), pair)
+
+		// With duplicate names, the group that participated in the match wins.
+		if name := names[idx]; name != "" {
+			if _, ok := groups.Obj.GetOwnProperty(name); !ok || value.Type != ValueTypeUndefined {
+				CreateDataPropertyOrThrow(groups.Obj, StrKey(name), value)
+				CreateDataPropertyOrThrow(indexGroups.Obj, StrKey(name), pair)
+			}
+		}
+	}
+
+	CreateDataPropertyOrThrow(a, StrKey("groups"), groups)
+
+	// https://tc39.es/ecma262/#sec-makematchindicesindexpairarray
+	if flags.HasIndices {
+		CreateDataPropertyOrThrow(indices, StrKey("groups"), indexGroups)
+		CreateDataPropertyOrThrow(a, StrKey("indices"), NewObj(indices))
+	}
+	return NewObj(a)
+}
+
+// https://tc39.es/ecma262/#sec-advancestringindex
+func advanceStringIndex(s []uint16, index int64, fullUnicode bool) int64 {
+	if !fullUnicode || index+1 >= int64(len(s)) {
+		return index + 1
+	}
+
+	if utf16.IsSurrogate(rune(s[index])) && utf16.DecodeRune(rune(s[index]), rune(s[index+1])) != unicode.ReplacementChar {
+		return index + 2
+	}
+	return index + 1
+}
+
+func fullUnicode(flags string) bool {
+	return strings.ContainsAny(flags, "uv")
+}
+
+// https://tc39.es/ecma262/#sec-regexp.prototype-@@match
+func regExpPrototypeMatch(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		rx := thisObject(this, "RegExp.prototype[@@match]")
+		s := ToString(argument(args, 0))
+		input := toUTF16(s)
+
+		flags := ToString(rx.Get("flags", this))
+		if !strings.Contains(flags, "g") {
+			return r.regExpExec(rx, s, input)
+		}
+
+		Set(rx, StrKey("lastIndex"), NewInt(0), true)
+		var matches []Value
+		for {
+			result := r.regExpExec(rx, s, input)
+			if result.Type == ValueTypeNull {
+				if len(matches) == 0 {
+					return NewNull()
+				}
+				return NewObj(r.NewArray(matches))
+			}
+
+			matchStr := ToString(result.Obj.Get("0", result))
+			matches = append(matches, NewStr(matchStr))
+			if matchStr == "" {
+				r.advanceLastIndex(rx, input, fullUnicode(flags))
+			}
+		}
+	}
+}
+
+// advanceLastIndex moves lastIndex past an empty match, so that repeated
+// matching makes progress.
+func (r *Realm) advanceLastIndex(rx Object, input []uint16, unicode bool) {
+	thisIndex := ToLength(rx.Get("lastIndex", NewObj(rx)))
+	Set(rx, StrKey("lastIndex"), NewNumber(float64(advanceStringIndex(input, thisIndex, unicode))), true)
+}
+
+// https://tc39.es/ecma262/#sec-regexp-prototype-matchall
+func regExpPrototypeMatchAll(r *Realm, ctor *NativeFunction) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		rx := thisObject(this, "RegExp.prototype[@@matchAll]")
+		s := ToString(argument(args, 0))
+
+		c := SpeciesConstructor(rx, NewObj(ctor))
+		flags := ToString(rx.Get("flags", this))
+		matcher := Construct(c, []Value{this, NewStr(flags)}, nil).Obj
+		lastIndex := ToLength(rx.Get("lastIndex", this))
+		Set(matcher, StrKey("lastIndex"), NewNumber(float64(lastIndex)), true)
+
+		return NewObj(r.newRegExpStringIterator(matcher, s, strings.Contains(flags, "g"), fullUnicode(flags)))
+	}
+}
+
+// https://tc39.es/ecma262/#sec-regexp.prototype-@@replace
+func regExpPrototypeReplace(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		rx := thisObject(this, "RegExp.prototype[@@replace]")
+		s := ToString(argument(args, 0))
+		input := toUTF16(s)
+
+		replaceValue := argument(args, 1)
+		functionalReplace := IsCallable(replaceValue)
+		replaceTemplate := ""
+		if !functionalReplace {
+			replaceTemplate = ToString(replaceValue)
+		}
+
+		flags := ToString(rx.Get("flags", this))
+		global := strings.Contains(flags, "g")
+		if global {
+			Set(rx, StrKey("lastIndex"), NewInt(0), true)
+		}
+
+		var results []Value
+		for {
+			result := r.regExpExec(rx, s, input)
+			if result.Type == ValueTypeNull {
+				break
+			}
+
+			results = append(results, result)
+			if !global {
+				break
+			}
+
+			if ToString(result.Obj.Get("0", result)) == "" {
+				r.advanceLastIndex(rx, input, fullUnicode(flags))
+			}
+		}
+
+		var accumulated []uint16
+		nextSourcePosition := 0
+		for _, result := range results {
+			nCaptures := LengthOfArrayLike(result.Obj) - 1
+			if nCaptures < 0 {
+				nCaptures = 0
+			}
+
+			matched := ToString(result.Obj.Get("0", result))
+			matchLength := len(toUTF16(matched))
+			position := int(math.Max(math.Min(ToIntegerOrInfinity(result.Obj.Get("index", result)), float64(len(input))), 0))
+
+			captures := make([]Value, 0, nCaptures)
+			for n := int64(1); n <= nCaptures; n++ {
+				capN := result.Obj.Get(strconv.FormatInt(n, 10), result)
+				if capN.Type != ValueTypeUndefined {
+					capN = NewStr(ToString(capN))
+				}
+				captures = append(captures, capN)
+			}
+
+			namedCaptures := result.Obj.Get("groups", result)
+
+			var replacement string
+			if functionalReplace {
+				replacerArgs := append([]Value{NewStr(matched)}, captures...)
+				replacerArgs = append(replacerArgs, NewInt(position), NewStr(s))
+				if namedCaptures.Type != ValueTypeUndefined {
+					replacerArgs = append(replacerArgs, namedCaptures)
+				}
+				replacement = ToString(Call(replaceValue, NewUndefined(), replacerArgs...))
+			} else {
+				if namedCaptures.Type != ValueTypeUndefined {
+					namedCaptures = NewObj(r.ToObject(namedCaptures))
+				}
+				replacement = GetSubstitution(matched, input, position, captures, namedCaptures, replaceTemplate)
+			}
+
+			if position >= nextSourcePosition {
+				accumulated = append(accumulated, input[nextSourcePosition:position]...)
+				accumulated = append(accumulated, toUTF16(replacement)...)
+				nextSourcePosition = position + matchLength
+			}
+		}
+
+		if nextSourcePosition >= len(input) {
+			return NewStr(fromUTF16(accumulated))
+		}
+		return NewStr(fromUTF16(append(accumulated, input[nextSourcePosition:]...)))
+	}
+}
+
+// GetSubstitution expands the $ patterns of a replacement template, for a
+// match in the string with the code units str.
+// https://tc39.es/ecma262/#sec-getsubstitution
+func GetSubstitution(matched string, s []uint16, position int, captures []Value, namedCaptures Value, template string) string {
+	tailPos := position + len(toUTF16(matched))
+
+	var b strings.Builder
+	for idx := 0; idx < len(template); idx++ {
+		ch := template[idx]
+		if ch != '$' || idx+1 == len(template) {
+			b.WriteByte(ch)
+			continue
+		}
+
+		switch next := template[idx+1]; {
+		case next == '$':
+			b.WriteByte('$')
+			idx++
+		case next == '&':
+			b.WriteString(matched)
+			idx++
+		case next == '`':
+			b.WriteString(fromUTF16(s[:position]))
+			idx++
+		case next == '\'':
+			if tailPos < len(s) {
+				b.WriteString(fromUTF16(s[tailPos:]))
+			}
+			idx++
+		case next >= '0' && next <= '9':
+			digits := 1
+			if idx+2 < len(template) && template[idx+2] >= '0' && template[idx+2] <= '9' {
+				digits = 2
+			}
+
+			index, _ := strconv.Atoi(template[idx+1 : idx+1+digits])
+			if index > len(captures) && digits == 2 {
+				digits = 1
+				index = int(next - '0')
+			}
+
+			if index < 1 || index > len(captures) {
+				b.WriteString(template[idx : idx+1+digits])
+			} else if capture := captures[index-1]; capture.Type != ValueTypeUndefined {
+				b.WriteString(ToString(capture))
+			}
+			idx += digits
+		case next == '<':
+			gtPos := strings.IndexByte(template[idx+2:], '>')
+			if gtPos < 0 || namedCaptures.Type == ValueTypeUndefined {
+				b.WriteString("$<")
+				idx++
+				continue
+			}
+
+			groupName := template[idx+2 : idx+2+gtPos]
+			if capture := namedCaptures.Obj.Get(groupName, namedCaptures); capture.Type != ValueTypeUndefined {
+				b.WriteString(ToString(capture))
+			}
+			idx += 2 + gtPos
+		default:
+			b.WriteByte('$')
+		}
+	}
+	return b.String()
+}
+
+// https://tc39.es/ecma262/#sec-regexp.prototype-@@search
+func regExpPrototypeSearch(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		rx := thisObject(this, "RegExp.prototype[@@search]")
+		s := ToString(argument(args, 0))
+
+		previousLastIndex := rx.Get("lastIndex", this)
+		if !SameValue(previousLastIndex, NewInt(0)) {
+			Set(rx, StrKey("lastIndex"), NewInt(0), true)
+		}
+
+		result := r.RegExpExec(rx, s)
+
+		if currentLastIndex := rx.Get("lastIndex", this); !SameValue(currentLastIndex, previousLastIndex) {
+			Set(rx, StrKey("lastIndex"), previousLastIndex, true)
+		}
+
+		if result.Type == ValueTypeNull {
+			return NewInt(-1)
+		}
+		return result.Obj.Get("index", result)
+	}
+}
+
+// https://tc39.es/ecma262/#sec-regexp.prototype-@@split
+func regExpPrototypeSplit(r *Realm, ctor *NativeFunction) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		rx := thisObject(this, "RegExp.prototype[@@split]")
+		s := ToString(argument(args, 0))
+		input := toUTF16(s)
+		size := int64(len(input))
+
+		c := SpeciesConstructor(rx, NewObj(ctor))
+		flags := ToString(rx.Get("flags", this))
+		unicodeMatching := fullUnicode(flags)
+		newFlags := flags
+		if !strings.Contains(flags, "y") {
+			newFlags += "y"
+		}
+		splitter := Construct(c, []Value{this, NewStr(newFlags)}, nil).Obj
+
+		var a []Value
+		lim := uint32(math.MaxUint32)
+		if limit := argument(args, 1); limit.Type != ValueTypeUndefined {
+			lim = ToUint32(limit)
+		}
+
+		if lim == 0 {
+			return NewObj(r.NewArray(nil))
+		}
+
+		if size == 0 {
+			if r.regExpExec(splitter, s, input).Type != ValueTypeNull {
+				return NewObj(r.NewArray(nil))
+			}
+			return NewObj(r.NewArray([]Value{NewStr(s)}))
+		}
+
+		p, q := int64(0), int64(0)
+		for q < size {
+			Set(splitter, StrKey("lastIndex"), NewNumber(float64(q)), true)
+			z := r.regExpExec(splitter, s, input)
+			if z.Type == ValueTypeNull {
+				q = advanceStringIndex(input, q, unicodeMatching)
+				continue
+			}
+
+			e := ToLength(splitter.Get("lastIndex", NewObj(splitter)))
+			if e > size {
+				e = size
+			}
+
+			if e == p {
+				q = advanceStringIndex(input, q, unicodeMatching)
+				continue
+			}
+
+			a = append(a, NewStr(fromUTF16(input[p:q])))
+			if uint32(len(a)) == lim {
+				return NewObj(r.NewArray(a))
+			}
+
+			p = e
+			numberOfCaptures := LengthOfArrayLike(z.Obj) - 1
+			for i := int64(1); i <= numberOfCaptures; i++ {
+				a = append(a, z.Obj.Get(strconv.FormatInt(i, 10), z))
+				if uint32(len(a)) == lim {
+					return NewObj(r.NewArray(a))
+				}
+			}
+			q = p
+		}
+
+		a = append(a, NewStr(fromUTF16(input[p:size])))
+		return NewObj(r.NewArray(a))
+	}
+}
+
+// https://tc39.es/ecma262/#sec-regexp-string-iterator-objects
+type regExpStringIterator struct {
+	JsObject
+	iteratingRegExp Object
+	iteratedString  string
+	iteratedUnits   []uint16
+	global, unicode bool
+	done            bool
+}
+
+// https://tc39.es/ecma262/#sec-createregexpstringiterator
+func (r *Realm) newRegExpStringIterator(rx Object, s string, global, unicode bool) *regExpStringIterator {
+	it := &regExpStringIterator{iteratingRegExp: rx, iteratedString: s, iteratedUnits: toUTF16(s), global: global, unicode: unicode}
+	it.init(r.RegExpStringIteratorPrototype)
+	return it
+}
+
+// https://tc39.es/ecma262/#sec-%regexpstringiteratorprototype%.next
+func regExpStringIteratorNext(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		it, ok := this.Obj.(*regExpStringIterator)
+		if !ok || this.Type != ValueTypeObj {
+			ThrowTypeError("%%RegExpStringIteratorPrototype%%.next called on incompatible receiver")
+		}
+
+		if it.done {
+			return NewObj(r.CreateIterResultObject(NewUndefined(), true))
+		}
+
+		match := r.regExpExec(it.iteratingRegExp, it.iteratedString, it.iteratedUnits)
+		if match.Type == ValueTypeNull {
+			it.done = true
+			return NewObj(r.CreateIterResultObject(NewUndefined(), true))
+		}
+
+		if !it.global {
+			it.done = true
+		} else if ToString(match.Obj.Get("0", match)) == "" {
+			r.advanceLastIndex(it.iteratingRegExp, it.iteratedUnits, it.unicode)
+		}
+		return NewObj(r.CreateIterResultObject(match, false))
+	}
+}
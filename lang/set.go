@@ -0,0 +1,138 @@
+package lang
+
+// SetObject is a Set instance, whose entries map each value to itself.
+// https://tc39.es/ecma262/#sec-properties-of-set-instances
+type SetObject struct {
+	JsObject
+	mapData
+}
+
+func (r *Realm) initSet() {
+	r.SetPrototype = r.NewObject()
+
+	// https://tc39.es/ecma262/#sec-set-iterable
+	ctor := r.defineBuiltinConstructor("Set", 0, func(this Value, args []Value) Value {
+		ThrowTypeError("Constructor Set requires 'new'")
+		return NewUndefined()
+	}, r.SetPrototype)
+	ctor.Constructor = func(args []Value, newTarget Object) Value {
+		s := &SetObject{mapData: newMapData()}
+		s.init(GetPrototypeFromConstructor(newTarget, r.SetPrototype))
+
+		iterable := argument(args, 0)
+		if iterable.Type == ValueTypeUndefined || iterable.Type == ValueTypeNull {
+			return NewObj(s)
+		}
+
+		adder := s.Get("add", NewObj(s))
+		if !IsCallable(adder) {
+			ThrowTypeError("'%s' returned for property 'add' of object is not a function", adder.String())
+		}
+
+		ir := r.GetIterator(iterable)
+		for next, ok := ir.StepValue(); ok; next, ok = ir.StepValue() {
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						ir.CloseAfterThrow()
+						panic(r)
+					}
+				}()
+				Call(adder, NewObj(s), next)
+			}()
+		}
+		return NewObj(s)
+	}
+
+	p := r.SetPrototype
+	r.defineBuiltinMethod(p, "add", 1, setPrototypeAdd)
+	r.defineBuiltinMethod(p, "clear", 0, setPrototypeClear)
+	r.defineBuiltinMethod(p, "delete", 1, setPrototypeDelete)
+	r.defineBuiltinMethod(p, "forEach", 1, setPrototypeForEach)
+	r.defineBuiltinMethod(p, "has", 1, setPrototypeHas)
+	r.defineBuiltinGetter(p, "size", setPrototypeSize)
+	r.defineBuiltinMethod(p, "entries", 0, setPrototypeIterator(r, arrayIteratorKindKeyValue))
+
+	// https://tc39.es/ecma262/#sec-set.prototype.keys
+	values := r.defineBuiltinMethod(p, "values", 0, setPrototypeIterator(r, arrayIteratorKindValue))
+	p.DefineOwnProperty("keys", NewDataDescriptor(NewObj(values), true, false, true))
+	SymbolKey(SymbolIterator).DefineOwnProperty(p, NewDataDescriptor(NewObj(values), true, false, true))
+	r.defineToStringTag(p, "Set")
+	r.defineBuiltinSpeciesGetter(ctor)
+
+	r.SetIteratorPrototype = NewJsObject(r.IteratorPrototype)
+	r.defineBuiltinMethod(r.SetIteratorPrototype, "next", 0, setIteratorNext(r))
+	r.defineToStringTag(r.SetIteratorPrototype, "Set Iterator")
+}
+
+func thisSetObject(this Value, method string) *SetObject {
+	if s, ok := this.Obj.(*SetObject); ok && this.Type == ValueTypeObj {
+		return s
+	}
+	ThrowTypeError("Method Set.prototype.%s called on incompatible receiver %s", method, this.String())
+	return nil
+}
+
+// https://tc39.es/ecma262/#sec-set.prototype.add
+func setPrototypeAdd(this Value, args []Value) Value {
+	value := argument(args, 0)
+	thisSetObject(this, "add").set(value, value)
+	return this
+}
+
+// https://tc39.es/ecma262/#sec-set.prototype.clear
+func setPrototypeClear(this Value, args []Value) Value {
+	thisSetObject(this, "clear").clear()
+	return NewUndefined()
+}
+
+// https://tc39.es/ecma262/#sec-set.prototype.delete
+func setPrototypeDelete(this Value, args []Value) Value {
+	return NewBool(thisSetObject(this, "delete").delete(argument(args, 0)))
+}
+
+// https://tc39.es/ecma262/#sec-set.prototype.foreach
+func setPrototypeForEach(this Value, args []Value) Value {
+	s := thisSetObject(this, "forEach")
+	callback := argument(args, 0)
+	if !IsCallable(callback) {
+		ThrowTypeError("%s is not a function", callback.String())
+	}
+
+	s.forEach(func(entry *mapEntry) {
+		Call(callback, argument(args, 1), entry.key, entry.key, this)
+	})
+	return NewUndefined()
+}
+
+// https://tc39.es/ecma262/#sec-set.prototype.has
+func setPrototypeHas(this Value, args []Value) Value {
+	_, ok := thisSetObject(this, "has").get(argument(args, 0))
+	return NewBool(ok)
+}
+
+// https://tc39.es/ecma262/#sec-get-set.prototype.size
+func setPrototypeSize(this Value, args []Value) Value {
+	return NewInt(thisSetObject(this, "size").size)
+}
+
+// https://tc39.es/ecma262/#sec-createsetiterator
+func setPrototypeIterator(r *Realm, kind arrayIteratorKind) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		s := thisSetObject(this, "values")
+		it := &mapIterator{cursor: s.cursor(), kind: kind, set: true}
+		it.init(r.SetIteratorPrototype)
+		return NewObj(it)
+	}
+}
+
+// https://tc39.es/ecma262/#sec-%setiteratorprototype%.next
+func setIteratorNext(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		it, ok := this.Obj.(*mapIterator)
+		if !ok || this.Type != ValueTypeObj || !it.set {
+			ThrowTypeError("%%SetIteratorPrototype%%.next called on incompatible receiver")
+		}
+		return it.next(r)
+	}
+}
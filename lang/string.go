@@ -0,0 +1,218 @@
+package lang
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// https://tc39.es/ecma262/#sec-string-constructor-string-value
+func stringConstructor(this Value, args []Value) Value {
+	if len(args) == 0 {
+		return NewStr("")
+	}
+
+	if args[0].Type == ValueTypeSymbol {
+		return NewStr(args[0].Symbol.DescriptiveString())
+	}
+	return NewStr(ToString(args[0]))
+}
+
+// stringConstruct wraps the string in a String object when String is called
+// as a constructor.
+// https://tc39.es/ecma262/#sec-string-constructor-string-value
+func stringConstruct(r *Realm) func(args []Value, newTarget Object) Value {
+	return func(args []Value, newTarget Object) Value {
+		o := r.NewStringObject(ToString(stringConstructor(NewUndefined(), args)))
+		o.SetPrototypeOf(GetPrototypeFromConstructor(newTarget, r.StringPrototype))
+		return NewObj(o)
+	}
+}
+
+// https://tc39.es/ecma262/#sec-string.raw
+func stringRaw(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		template := NewUndefined()
+		if len(args) > 0 {
+			template, args = args[0], args[1:]
+		}
+
+		cooked := r.ToObject(template)
+		literals := r.ToObject(cooked.Get("raw", NewObj(cooked)))
+		literalCount := ToLength(literals.Get("length", NewObj(literals)))
+
+		var parts []string
+		for nextIndex := int64(0); nextIndex < literalCount; nextIndex++ {
+			parts = append(parts, ToString(literals.Get(strconv.FormatInt(nextIndex, 10), NewObj(literals))))
+			if nextIndex+1 < literalCount && nextIndex < int64(len(args)) {
+				parts = append(parts, ToString(args[nextIndex]))
+			}
+		}
+		return NewStr(ConcatStrings(parts...))
+	}
+}
+
+// https://tc39.es/ecma262/#sec-string.prototype.match
+func stringPrototypeMatch(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		o := RequireObjectCoercible(this)
+		regexp := argument(args, 0)
+		if regexp.Type != ValueTypeUndefined && regexp.Type != ValueTypeNull {
+			if matcher := r.GetMethod(regexp, SymbolKey(SymbolMatch)); matcher.Type != ValueTypeUndefined {
+				return Call(matcher, regexp, o)
+			}
+		}
+
+		s := ToString(o)
+		rx := r.RegExpCreate(regexp, NewUndefined())
+		return r.Invoke(NewObj(rx), SymbolKey(SymbolMatch), NewStr(s))
+	}
+}
+
+// https://tc39.es/ecma262/#sec-string.prototype.matchall
+func stringPrototypeMatchAll(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		o := RequireObjectCoercible(this)
+		regexp := argument(args, 0)
+		if regexp.Type != ValueTypeUndefined && regexp.Type != ValueTypeNull {
+			if r.isRegExp(regexp) {
+				flags := RequireObjectCoercible(regexp.Obj.Get("flags", regexp))
+				if !strings.Contains(ToString(flags), "g") {
+					ThrowTypeError("String.prototype.matchAll called with a non-global RegExp argument")
+				}
+			}
+
+			if matcher := r.GetMethod(regexp, SymbolKey(SymbolMatchAll)); matcher.Type != ValueTypeUndefined {
+				return Call(matcher, regexp, o)
+			}
+		}
+
+		s := ToString(o)
+		rx := r.RegExpCreate(regexp, NewStr("g"))
+		return r.Invoke(NewObj(rx), SymbolKey(SymbolMatchAll), NewStr(s))
+	}
+}
+
+// https://tc39.es/ecma262/#sec-string.prototype.replace
+func stringPrototypeReplace(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		o := RequireObjectCoercible(this)
+		searchValue, replaceValue := argument(args, 0), argument(args, 1)
+		if searchValue.Type != ValueTypeUndefined && searchValue.Type != ValueTypeNull {
+			if replacer := r.GetMethod(searchValue, SymbolKey(SymbolReplace)); replacer.Type != ValueTypeUndefined {
+				return Call(replacer, searchValue, o, replaceValue)
+			}
+		}
+
+		str := ToString(o)
+		searchString := ToString(searchValue)
+		functionalReplace := IsCallable(replaceValue)
+		replaceTemplate := ""
+		if !functionalReplace {
+			replaceTemplate = ToString(replaceValue)
+		}
+
+		s, search := toUTF16(str), toUTF16(searchString)
+		position := stringIndexOf(s, search, 0)
+		if position < 0 {
+			return NewStr(str)
+		}
+
+		var replacement string
+		if functionalReplace {
+			replacement = ToString(Call(replaceValue, NewUndefined(), NewStr(searchString), NewInt(position), NewStr(str)))
+		} else {
+			replacement = GetSubstitution(searchString, s, position, nil, NewUndefined(), replaceTemplate)
+		}
+		return NewStr(ConcatStrings(fromUTF16(s[:position]), replacement, fromUTF16(s[position+len(search):])))
+	}
+}
+
+// https://tc39.es/ecma262/#sec-string.prototype.search
+func stringPrototypeSearch(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		o := RequireObjectCoercible(this)
+		regexp := argument(args, 0)
+		if regexp.Type != ValueTypeUndefined && regexp.Type != ValueTypeNull {
+			if searcher := r.GetMethod(regexp, SymbolKey(SymbolSearch)); searcher.Type != ValueTypeUndefined {
+				return Call(searcher, regexp, o)
+			}
+		}
+
+		s := ToString(o)
+		rx := r.RegExpCreate(regexp, NewUndefined())
+		return r.Invoke(NewObj(rx), SymbolKey(SymbolSearch), NewStr(s))
+	}
+}
+
+// https://tc39.es/ecma262/#sec-string.prototype.split
+func stringPrototypeSplit(r *Realm) func(this Value, args []Value) Value {
+	return func(this Value, args []Value) Value {
+		o := RequireObjectCoercible(this)
+		separator, limit := argument(args, 0), argument(args, 1)
+		if separator.Type != ValueTypeUndefined && separator.Type != ValueTypeNull {
+			if splitter := r.GetMethod(separator, SymbolKey(SymbolSplit)); splitter.Type != ValueTypeUndefined {
+				return Call(splitter, separator, o, limit)
+			}
+		}
+
+		str := ToString(o)
+		lim := uint32(math.MaxUint32)
+		if limit.Type != ValueTypeUndefined {
+			lim = ToUint32(limit)
+		}
+
+		sep := ToString(separator)
+		if lim == 0 {
+			return NewObj(r.NewArray(nil))
+		}
+
+		if separator.Type == ValueTypeUndefined {
+			return NewObj(r.NewArray([]Value{NewStr(str)}))
+		}
+
+		s, r16 := toUTF16(str), toUTF16(sep)
+		if len(r16) == 0 {
+			var units []Value
+			for idx := 0; idx < len(s) && uint32(idx) < lim; idx++ {
+				units = append(units, NewStr(fromUTF16(s[idx:idx+1])))
+			}
+			return NewObj(r.NewArray(units))
+		}
+
+		if len(s) == 0 {
+			return NewObj(r.NewArray([]Value{NewStr(str)}))
+		}
+
+		var substrings []Value
+		i := 0
+		for j := stringIndexOf(s, r16, 0); j >= 0; j = stringIndexOf(s, r16, i) {
+			substrings = append(substrings, NewStr(fromUTF16(s[i:j])))
+			if uint32(len(substrings)) == lim {
+				return NewObj(r.NewArray(substrings))
+			}
+			i = j + len(r16)
+		}
+
+		substrings = append(substrings, NewStr(fromUTF16(s[i:])))
+		return NewObj(r.NewArray(substrings))
+	}
+}
+
+// https://tc39.es/ecma262/#sec-stringindexof
+func stringIndexOf(s, search []uint16, fromIndex int) int {
+	for idx := fromIndex; idx+len(search) <= len(s); idx++ {
+		match := true
+		for k := range search {
+			if s[idx+k] != search[k] {
+				match = false
+				break
+			}
+		}
+
+		if match {
+			return idx
+		}
+	}
+	return -1
+}
@@ -0,0 +1,171 @@
+package lang
+
+import "sync"
+
+// Symbol is a unique value that can be used as a property key. Its
+// description is a String, or undefined.
+// https://tc39.es/ecma262/#sec-ecmascript-language-types-symbol-type
+type Symbol struct {
+	Description Value
+}
+
+// The well-known symbols are shared by all realms.
+// https://tc39.es/ecma262/#sec-well-known-symbols
+var (
+	SymbolAsyncIterator      = &Symbol{Description: NewStr("Symbol.asyncIterator")}
+	SymbolHasInstance        = &Symbol{Description: NewStr("Symbol.hasInstance")}
+	SymbolIsConcatSpreadable = &Symbol{Description: NewStr("Symbol.isConcatSpreadable")}
+	SymbolIterator           = &Symbol{Description: NewStr("Symbol.iterator")}
+	SymbolMatch              = &Symbol{Description: NewStr("Symbol.match")}
+	SymbolMatchAll           = &Symbol{Description: NewStr("Symbol.matchAll")}
+	SymbolReplace            = &Symbol{Description: NewStr("Symbol.replace")}
+	SymbolSearch             = &Symbol{Description: NewStr("Symbol.search")}
+	SymbolSpecies            = &Symbol{Description: NewStr("Symbol.species")}
+	SymbolSplit              = &Symbol{Description: NewStr("Symbol.split")}
+	SymbolToPrimitive        = &Symbol{Description: NewStr("Symbol.toPrimitive")}
+	SymbolToStringTag        = &Symbol{Description: NewStr("Symbol.toStringTag")}
+	SymbolUnscopables        = &Symbol{Description: NewStr("Symbol.unscopables")}
+)
+
+// wellKnownSymbols are the well-known symbols by the names of the properties
+// of the Symbol constructor holding them.
+var wellKnownSymbols = []struct {
+	name   string
+	symbol *Symbol
+}{
+	{"asyncIterator", SymbolAsyncIterator},
+	{"hasInstance", SymbolHasInstance},
+	{"isConcatSpreadable", SymbolIsConcatSpreadable},
+	{"iterator", SymbolIterator},
+	{"match", SymbolMatch},
+	{"matchAll", SymbolMatchAll},
+	{"replace", SymbolReplace},
+	{"search", SymbolSearch},
+	{"species", SymbolSpecies},
+	{"split", SymbolSplit},
+	{"toPrimitive", SymbolToPrimitive},
+	{"toStringTag", SymbolToStringTag},
+	{"unscopables", SymbolUnscopables},
+}
+
+// https://tc39.es/ecma262/#sec-symboldescriptivestring
+func (s *Symbol) DescriptiveString() string {
+	if s.Description.Type == ValueTypeUndefined {
+		return "Symbol()"
+	}
+	return "Symbol(" + s.Description.Str + ")"
+}
+
+// The registry is shared by all realms, which may be used from different
+// goroutines.
+// https://tc39.es/ecma262/#sec-globalsymbolregistry-record
+var globalSymbolRegistry = struct {
+	sync.Mutex
+	symbols map[string]*Symbol
+}{symbols: make(map[string]*Symbol)}
+
+// SymbolObject is a Symbol wrapper object.
+// https://tc39.es/ecma262/#sec-properties-of-symbol-instances
+type SymbolObject struct {
+	JsObject
+	SymbolData *Symbol
+}
+
+func (r *Realm) NewSymbolObject(s *Symbol) *SymbolObject {
+	o := &SymbolObject{SymbolData: s}
+	o.init(r.SymbolPrototype)
+	return o
+}
+
+func (r *Realm) initSymbol() {
+	r.SymbolPrototype = r.NewObject()
+
+	// https://tc39.es/ecma262/#sec-symbol-description
+	ctor := r.defineBuiltinConstructor("Symbol", 0, func(this Value, args []Value) Value {
+		description := NewUndefined()
+		if d := argument(args, 0); d.Type != ValueTypeUndefined {
+			description = NewStr(ToString(d))
+		}
+		return NewSymbol(&Symbol{Description: description})
+	}, r.SymbolPrototype)
+	ctor.Constructor = func(args []Value, newTarget Object) Value {
+		ThrowTypeError("Symbol is not a constructor")
+		return NewUndefined()
+	}
+
+	for _, s := range wellKnownSymbols {
+		ctor.DefineOwnProperty(s.name, NewDataDescriptor(NewSymbol(s.symbol), false, false, false))
+	}
+	r.defineBuiltinMethod(ctor, "for", 1, symbolFor)
+	r.defineBuiltinMethod(ctor, "keyFor", 1, symbolKeyFor)
+
+	p := r.SymbolPrototype
+	r.defineBuiltinMethod(p, "toString", 0, symbolPrototypeToString)
+	r.defineBuiltinMethod(p, "valueOf", 0, symbolPrototypeValueOf)
+	r.defineBuiltinGetter(p, "description", symbolPrototypeDescription)
+
+	toPrimitive := r.NewNativeFunction(SymbolKey(SymbolToPrimitive).FunctionName(), 1, symbolPrototypeValueOf)
+	SymbolKey(SymbolToPrimitive).DefineOwnProperty(p, NewDataDescriptor(NewObj(toPrimitive), false, false, true))
+	r.defineToStringTag(p, "Symbol")
+}
+
+// https://tc39.es/ecma262/#sec-symbol.for
+func symbolFor(this Value, args []Value) Value {
+	key := ToString(argument(args, 0))
+
+	globalSymbolRegistry.Lock()
+	defer globalSymbolRegistry.Unlock()
+
+	s, ok := globalSymbolRegistry.symbols[key]
+	if !ok {
+		s = &Symbol{Description: NewStr(key)}
+		globalSymbolRegistry.symbols[key] = s
+	}
+	return NewSymbol(s)
+}
+
+// https://tc39.es/ecma262/#sec-symbol.keyfor
+func symbolKeyFor(this Value, args []Value) Value {
+	sym := argument(args, 0)
+	if sym.Type != ValueTypeSymbol {
+		ThrowTypeError("%s is not a symbol", ToString(sym))
+	}
+
+	globalSymbolRegistry.Lock()
+	defer globalSymbolRegistry.Unlock()
+
+	for key, s := range globalSymbolRegistry.symbols {
+		if s == sym.Symbol {
+			return NewStr(key)
+		}
+	}
+	return NewUndefined()
+}
+
+// https://tc39.es/ecma262/#sec-thissymbolvalue
+func thisSymbolValue(this Value, method string) *Symbol {
+	if this.Type == ValueTypeSymbol {
+		return this.Symbol
+	}
+	if o, ok := this.Obj.(*SymbolObject); ok && this.Type == ValueTypeObj {
+		return o.SymbolData
+	}
+	ThrowTypeError("Symbol.prototype.%s requires that 'this' be a Symbol", method)
+	return nil
+}
+
+// https://tc39.es/ecma262/#sec-symbol.prototype.tostring
+func symbolPrototypeToString(this Value, args []Value) Value {
+	return NewStr(thisSymbolValue(this, "toString").DescriptiveString())
+}
+
+// https://tc39.es/ecma262/#sec-symbol.prototype.valueof
+// https://tc39.es/ecma262/#sec-symbol.prototype-@@toprimitive
+func symbolPrototypeValueOf(this Value, args []Value) Value {
+	return NewSymbol(thisSymbolValue(this, "valueOf"))
+}
+
+// https://tc39.es/ecma262/#sec-symbol.prototype.description
+func symbolPrototypeDescription(this Value, args []Value) Value {
+	return thisSymbolValue(this, "description").Description
+}
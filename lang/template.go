@@ -0,0 +1,33 @@
+package lang
+
+import (
+	"gojs/ast"
+)
+
+// GetTemplateObject returns the frozen template object of a template literal
+// site. The same object is returned every time the site is evaluated.
+// https://tc39.es/ecma262/#sec-gettemplateobject
+func (r *Realm) GetTemplateObject(site *ast.TemplateLiteral) *Array {
+	if template, ok := r.templateMap[site]; ok {
+		return template
+	}
+
+	cookedStrings := make([]Value, len(site.Quasis))
+	rawStrings := make([]Value, len(site.Quasis))
+	for idx, quasi := range site.Quasis {
+		cookedStrings[idx] = NewUndefined()
+		if quasi.HasCooked {
+			cookedStrings[idx] = NewStr(quasi.Cooked)
+		}
+		rawStrings[idx] = NewStr(quasi.Raw)
+	}
+
+	template := r.NewArray(cookedStrings)
+	rawObj := r.NewArray(rawStrings)
+	SetIntegrityLevel(rawObj, IntegrityLevelFrozen)
+	template.DefineOwnProperty("raw", NewDataDescriptor(NewObj(rawObj), false, false, false))
+	SetIntegrityLevel(template, IntegrityLevelFrozen)
+
+	r.templateMap[site] = template
+	return template
+}
@@ -0,0 +1,229 @@
+package lang
+
+import (
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// A String value is a sequence of UTF-16 code units, which need not be well
+// formed. Value.Str holds the code units as WTF-8: surrogate pairs are
+// encoded as the code point they stand for, like UTF-8, and lone surrogates
+// as three-byte sequences of their own. Every well-formed string is therefore
+// valid UTF-8, and ASCII strings can be measured and indexed by byte.
+// https://tc39.es/ecma262/#sec-ecmascript-language-types-string-type
+// https://simonsapin.github.io/wtf-8/
+
+func isASCII(s string) bool {
+	for idx := 0; idx < len(s); idx++ {
+		if s[idx] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeWTF8 decodes the first code point of s and its width in bytes. Lone
+// surrogates are decoded as themselves.
+func decodeWTF8(s string) (rune, int) {
+	if len(s) >= 3 && s[0] == 0xED && s[1] >= 0xA0 && s[1] <= 0xBF && s[2]&0xC0 == 0x80 {
+		return 0xD000 | rune(s[1]&0x3F)<<6 | rune(s[2]&0x3F), 3
+	}
+	return utf8.DecodeRuneInString(s)
+}
+
+func appendWTF8(b []byte, r rune) []byte {
+	if utf16.IsSurrogate(r) {
+		return append(b, 0xE0|byte(r>>12), 0x80|byte(r>>6)&0x3F, 0x80|byte(r)&0x3F)
+	}
+
+	var buf [utf8.UTFMax]byte
+	return append(b, buf[:utf8.EncodeRune(buf[:], r)]...)
+}
+
+// toUTF16 returns the code units of a string, which is how regular
+// expressions index their input.
+func toUTF16(s string) []uint16 {
+	units := make([]uint16, 0, len(s))
+	for idx := 0; idx < len(s); {
+		if s[idx] < utf8.RuneSelf {
+			units = append(units, uint16(s[idx]))
+			idx++
+			continue
+		}
+
+		r, size := decodeWTF8(s[idx:])
+		if r >= 0x10000 {
+			lead, trail := utf16.EncodeRune(r)
+			units = append(units, uint16(lead), uint16(trail))
+		} else {
+			units = append(units, uint16(r))
+		}
+		idx += size
+	}
+	return units
+}
+
+func fromUTF16(s []uint16) string {
+	b := make([]byte, 0, len(s))
+	for idx := 0; idx < len(s); idx++ {
+		r := rune(s[idx])
+		if r < utf8.RuneSelf {
+			b = append(b, byte(r))
+			continue
+		}
+
+		if utf16.IsSurrogate(r) && idx+1 < len(s) {
+			if pair := utf16.DecodeRune(r, rune(s[idx+1])); pair != utf8.RuneError {
+				r = pair
+				idx++
+			}
+		}
+		b = appendWTF8(b, r)
+	}
+	return string(b)
+}
+
+// shortString is the length in bytes up to which a String value is measured
+// whenever it is indexed; longer ones cache their stringInfo.
+const shortString = 32
+
+// stringInfo holds what indexing a string by code unit takes: whether it is
+// ASCII, which is indexed by byte, and otherwise its code units.
+type stringInfo struct {
+	measured bool
+	ascii    bool
+	length   int
+	units    []uint16
+}
+
+// stringInfo returns the measurements of a String value, computing them on
+// first use.
+func (v Value) stringInfo() *stringInfo {
+	info := v.info
+	if info == nil {
+		info = &stringInfo{}
+	}
+
+	if !info.measured {
+		info.measured = true
+		if info.ascii = isASCII(v.Str); info.ascii {
+			info.length = len(v.Str)
+		} else {
+			info.units = toUTF16(v.Str)
+			info.length = len(info.units)
+		}
+	}
+	return info
+}
+
+// stringLength returns the number of code units of a String value.
+func (v Value) stringLength() int {
+	return v.stringInfo().length
+}
+
+// codeUnitAt returns the string holding the code unit of a String value at an
+// index, which must be less than its length.
+func (v Value) codeUnitAt(index int) string {
+	info := v.stringInfo()
+	if info.ascii {
+		return v.Str[index : index+1]
+	}
+	return fromUTF16(info.units[index : index+1])
+}
+
+// ConcatStrings returns the concatenation of strings. A lone lead surrogate
+// at the end of one string and a lone trail surrogate at the start of the
+// next form a pair, which is encoded as the code point it stands for.
+// https://tc39.es/ecma262/#sec-string-concatenation
+func ConcatStrings(parts ...string) string {
+	if len(parts) == 2 && !joinsSurrogates(parts[0], parts[1]) {
+		return parts[0] + parts[1]
+	}
+
+	size := 0
+	for _, part := range parts {
+		size += len(part)
+	}
+
+	b := make([]byte, 0, size)
+	for _, part := range parts {
+		if len(b) >= 3 && joinsSurrogates(string(b[len(b)-3:]), part) {
+			lead, _ := decodeWTF8(string(b[len(b)-3:]))
+			trail, _ := decodeWTF8(part)
+			b = appendWTF8(b[:len(b)-3], utf16.DecodeRune(lead, trail))
+			part = part[3:]
+		}
+		b = append(b, part...)
+	}
+	return string(b)
+}
+
+// joinsSurrogates reports whether a ends with a lone lead surrogate and b
+// starts with a lone trail surrogate.
+func joinsSurrogates(a, b string) bool {
+	return endsWithLeadSurrogate(a) &&
+		len(b) >= 3 && b[0] == 0xED && b[1]&0xF0 == 0xB0 && b[2]&0xC0 == 0x80
+}
+
+func endsWithLeadSurrogate(s string) bool {
+	return len(s) >= 3 && s[len(s)-3] == 0xED && s[len(s)-2]&0xF0 == 0xA0 && s[len(s)-1]&0xC0 == 0x80
+}
+
+// concatBuilder concatenates strings one at a time like ConcatStrings, for
+// when their number is not known in advance. A lone lead surrogate at the end
+// is held back until the next string shows whether it completes a pair.
+type concatBuilder struct {
+	b    strings.Builder
+	lead string
+}
+
+func (c *concatBuilder) WriteString(s string) {
+	if c.lead != "" && s != "" {
+		if joinsSurrogates(c.lead, s) {
+			lead, _ := decodeWTF8(c.lead)
+			trail, _ := decodeWTF8(s)
+			c.b.WriteRune(utf16.DecodeRune(lead, trail))
+			s = s[3:]
+		} else {
+			c.b.WriteString(c.lead)
+		}
+		c.lead = ""
+	}
+
+	if endsWithLeadSurrogate(s) {
+		s, c.lead = s[:len(s)-3], s[len(s)-3:]
+	}
+	c.b.WriteString(s)
+}
+
+func (c *concatBuilder) String() string {
+	return c.b.String() + c.lead
+}
+
+// compareStrings compares two strings by their code units, returning -1, 0
+// or +1.
+// https://tc39.es/ecma262/#sec-islessthan
+func compareStrings(a, b string) int {
+	if isASCII(a) && isASCII(b) {
+		return strings.Compare(a, b)
+	}
+
+	x, y := toUTF16(a), toUTF16(b)
+	for idx := 0; idx < len(x) && idx < len(y); idx++ {
+		if x[idx] != y[idx] {
+			if x[idx] < y[idx] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case len(x) < len(y):
+		return -1
+	case len(x) > len(y):
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,28 @@
+package lang
+
+import "testing"
+
+func TestConcatBuilder(t *testing.T) {
+	lead := fromUTF16([]uint16{0xD83D})
+	trail := fromUTF16([]uint16{0xDE00})
+
+	tests := [][]string{
+		{"a", "b"},
+		{"a" + lead, trail + "b"},
+		{lead, "", trail},
+		{lead, lead, trail},
+		{lead, "b", trail},
+		{"a", lead},
+		{trail, lead},
+	}
+
+	for _, parts := range tests {
+		var b concatBuilder
+		for _, part := range parts {
+			b.WriteString(part)
+		}
+		if got, want := b.String(), ConcatStrings(parts...); got != want {
+			t.Errorf("%q: got %q, want %q", parts, got, want)
+		}
+	}
+}
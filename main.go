@@ -33,10 +33,8 @@ func main() {
 				}
 `
 
-	t := tkn.Tokenizer{}
-	tokens := t.Tokenize(program)
-
-	p := parse.NewParser(tokens)
+	t := tkn.NewTokenizerBytes([]byte(program))
+	p := parse.NewStreamParser(t)
 	pp := p.Parse()
 
 	d := &ast.Dumper{Indent: 4}
@@ -0,0 +1,354 @@
+package parse
+
+import (
+	"gojs/ast"
+	"gojs/tkn"
+)
+
+// classScope records the private names a class body declares, and the ones
+// it references, which may be declared by an enclosing class instead.
+type classScope struct {
+	declared   map[string]string
+	referenced []string
+}
+
+// privateAccessorPairs maps the kind of a private accessor to the kind that
+// may share its name, so a getter and a setter form a single accessor.
+var privateAccessorPairs = map[string]string{
+	"get":        "set",
+	"set":        "get",
+	"static get": "static set",
+	"static set": "static get",
+}
+
+// https://tc39.es/ecma262/#prod-ClassDeclaration
+func (p *Parser) parseClassDeclaration() *ast.ClassDeclaration {
+	defer p.retain()()
+	start := p.consume(tkn.TokenKindClass).Start
+	id := p.parseClassName()
+	superClass, body := p.parseClassTail()
+
+	return &ast.ClassDeclaration{
+		Start:      start,
+		End:        body.End,
+		Id:         id,
+		SuperClass: superClass,
+		Body:       body,
+		SourceText: p.sourceText(start, body.End),
+	}
+}
+
+// https://tc39.es/ecma262/#prod-ClassExpression
+func (p *Parser) parseClassExpression() *ast.ClassExpression {
+	defer p.retain()()
+	start := p.consume(tkn.TokenKindClass).Start
+
+	var id *ast.Identifier
+	if p.match(tkn.TokenKindIdentifier) {
+		id = p.parseClassName()
+	}
+	superClass, body := p.parseClassTail()
+
+	return &ast.ClassExpression{
+		Start:      start,
+		End:        body.End,
+		Id:         id,
+		SuperClass: superClass,
+		Body:       body,
+		SourceText: p.sourceText(start, body.End),
+	}
+}
+
+// All parts of a class are strict mode code, including its name.
+// https://tc39.es/ecma262/#sec-strict-mode-code
+func (p *Parser) parseClassName() *ast.Identifier {
+	token := p.consume(tkn.TokenKindIdentifier)
+
+	outer := p.strict
+	p.strict = true
+	p.checkBindingIdentifier(token.Value)
+	p.strict = outer
+
+	return &ast.Identifier{Start: token.Start, End: token.End, Name: token.Value}
+}
+
+// https://tc39.es/ecma262/#prod-ClassTail
+func (p *Parser) parseClassTail() (ast.Expression, *ast.ClassBody) {
+	outer := p.strict
+	p.strict = true
+	defer func() {
+		p.strict = outer
+	}()
+	defer p.allowIn()()
+
+	var superClass ast.Expression
+	if p.match(tkn.TokenKindExtends) {
+		p.consume(tkn.TokenKindExtends)
+		superClass = p.parseLeftHandSideExpression()
+	}
+
+	start := p.consume(tkn.TokenKindLeftBrace).Start
+	p.classScopes = append(p.classScopes, &classScope{declared: make(map[string]string)})
+
+	var elements []ast.Node
+	hasConstructor := false
+	for !p.match(tkn.TokenKindRightBrace) {
+		if p.match(tkn.TokenKindSemicolon) {
+			p.consume(tkn.TokenKindSemicolon)
+			continue
+		}
+
+		element := p.parseClassElement(superClass != nil)
+		if method, ok := element.(*ast.MethodDefinition); ok && method.Kind == "constructor" {
+			if hasConstructor {
+				panic("a class may only have one constructor")
+			}
+			hasConstructor = true
+		}
+		elements = append(elements, element)
+	}
+	end := p.consume(tkn.TokenKindRightBrace).End
+
+	p.exitClassScope()
+	return superClass, &ast.ClassBody{Start: start, End: end, Body: elements}
+}
+
+// https://tc39.es/ecma262/#prod-ClassElement
+func (p *Parser) parseClassElement(derived bool) ast.Node {
+	defer p.retain()()
+	start := p.current.Start
+
+	// static is the name of a method or field when nothing follows it.
+	static := false
+	if p.matchContextual("static") && !p.matchesClassElementEnd(p.peek().Kind) {
+		p.consume(tkn.TokenKindIdentifier)
+		static = true
+
+		if p.match(tkn.TokenKindLeftBrace) {
+			return p.parseStaticBlock(start)
+		}
+		start = p.current.Start
+	}
+
+	generator := p.match(tkn.TokenKindAsterisk)
+	if generator {
+		p.consume(tkn.TokenKindAsterisk)
+	}
+
+	kind := "method"
+	if !generator && (p.matchContextual("get") || p.matchContextual("set")) && !p.matchesClassElementEnd(p.peek().Kind) {
+		kind = p.consume(tkn.TokenKindIdentifier).Value
+	}
+
+	key, computed := p.parseClassElementName()
+	name, literal := "", !computed
+	switch key := key.(type) {
+	case *ast.Identifier:
+		name = key.Name
+	case *ast.StringLiteral:
+		name = key.Value
+	default:
+		literal = false
+	}
+
+	// https://tc39.es/ecma262/#sec-class-definitions-static-semantics-early-errors
+	if static && literal && name == "prototype" {
+		panic("classes may not have a static property named 'prototype'")
+	}
+
+	if kind != "method" || generator || p.match(tkn.TokenKindLeftParen) {
+		constructor := !static && literal && name == "constructor"
+		if constructor && kind != "method" {
+			panic("class constructor may not be an accessor")
+		}
+		if constructor && generator {
+			panic("class constructor may not be a generator")
+		}
+		if constructor {
+			kind = "constructor"
+		}
+
+		if private, ok := key.(*ast.PrivateIdentifier); ok {
+			p.declarePrivateName(private.Name, kind, static)
+		}
+
+		value := p.parseMethod(start, kind, constructor && derived, generator)
+		return &ast.MethodDefinition{
+			Start:    start,
+			End:      value.End,
+			Key:      key,
+			Value:    value,
+			Kind:     kind,
+			Computed: computed,
+			Static:   static,
+		}
+	}
+
+	if literal && name == "constructor" {
+		panic("classes may not have a field named 'constructor'")
+	}
+	if private, ok := key.(*ast.PrivateIdentifier); ok {
+		p.declarePrivateName(private.Name, "field", static)
+	}
+
+	field := &ast.PropertyDefinition{Start: start, Key: key, Computed: computed, Static: static}
+	if p.match(tkn.TokenKindEqual) {
+		p.consume(tkn.TokenKindEqual)
+		field.Value = p.parseFieldInitializer()
+	}
+	field.End = p.previous.End
+
+	// https://tc39.es/ecma262/#sec-rules-of-automatic-semicolon-insertion
+	switch {
+	case p.match(tkn.TokenKindSemicolon):
+		p.consume(tkn.TokenKindSemicolon)
+	case !p.match(tkn.TokenKindRightBrace) && !p.current.NewlineBefore:
+		panic("expected ';' after class field but got kind: " + p.kind().String())
+	}
+	return field
+}
+
+// parseClassElementName parses a property name or a private name.
+// https://tc39.es/ecma262/#prod-ClassElementName
+func (p *Parser) parseClassElementName() (ast.Expression, bool) {
+	if p.match(tkn.TokenKindPrivateIdentifier) {
+		token := p.consume(tkn.TokenKindPrivateIdentifier)
+		return &ast.PrivateIdentifier{Start: token.Start, End: token.End, Name: token.Value[1:]}, false
+	}
+	return p.parsePropertyName()
+}
+
+// matchesClassElementEnd reports whether a token of the kind follows the name
+// of a method or field, rather than a name following a modifier.
+func (p *Parser) matchesClassElementEnd(k tkn.TokenKind) bool {
+	switch k {
+	case tkn.TokenKindLeftParen, tkn.TokenKindEqual, tkn.TokenKindSemicolon, tkn.TokenKindRightBrace:
+		return true
+	default:
+		return false
+	}
+}
+
+// Initializers are evaluated as methods of the class, with the instance as
+// their this value.
+// https://tc39.es/ecma262/#prod-FieldDefinition
+func (p *Parser) parseFieldInitializer() ast.Expression {
+	defer p.enterFunction(false, true, true)()
+
+	generator := p.generator
+	p.generator = false
+	defer func() {
+		p.generator = generator
+	}()
+	return p.parseAssignmentExpression()
+}
+
+// https://tc39.es/ecma262/#prod-ClassStaticBlock
+func (p *Parser) parseStaticBlock(start int) *ast.StaticBlock {
+	defer p.enterFunction(false, true, true)()
+
+	inFunction, generator := p.inFunction, p.generator
+	p.inFunction, p.generator = false, false
+	defer func() {
+		p.inFunction, p.generator = inFunction, generator
+	}()
+
+	p.consume(tkn.TokenKindLeftBrace)
+	statements := make([]ast.Statement, 0)
+	for !p.match(tkn.TokenKindRightBrace) {
+		statements = append(statements, p.parseStatement())
+	}
+	end := p.consume(tkn.TokenKindRightBrace).End
+	checkDeclarations(statements, true)
+
+	return &ast.StaticBlock{Start: start, End: end, Body: statements}
+}
+
+// enterFunction sets what the body of the function being parsed allows:
+// super calls, super property references, and whether it is a class field
+// initializer or static block, where arguments cannot be referenced. The
+// returned function restores the enclosing context.
+func (p *Parser) enterFunction(superCall, superProperty, fieldInitializer bool) func() {
+	outerCall, outerProperty, outerInitializer := p.superCall, p.superProperty, p.fieldInitializer
+	p.superCall, p.superProperty, p.fieldInitializer = superCall, superProperty, fieldInitializer
+
+	return func() {
+		p.superCall, p.superProperty, p.fieldInitializer = outerCall, outerProperty, outerInitializer
+	}
+}
+
+// parseSuper parses super, which must be called or have a property accessed.
+// https://tc39.es/ecma262/#prod-SuperCall
+// https://tc39.es/ecma262/#prod-SuperProperty
+func (p *Parser) parseSuper() *ast.Super {
+	token := p.consume(tkn.TokenKindSuper)
+
+	switch {
+	case p.match(tkn.TokenKindLeftParen) && p.superCall:
+	case (p.match(tkn.TokenKindPeriod) || p.match(tkn.TokenKindLeftSquareBracket)) && p.superProperty:
+		if p.peek().Kind == tkn.TokenKindPrivateIdentifier {
+			panic("unexpected private name after super")
+		}
+	default:
+		panic("'super' keyword unexpected here")
+	}
+	return &ast.Super{Start: token.Start, End: token.End}
+}
+
+// parsePrivateIdentifier parses a reference to a private name, which must be
+// declared by an enclosing class.
+func (p *Parser) parsePrivateIdentifier() *ast.PrivateIdentifier {
+	token := p.consume(tkn.TokenKindPrivateIdentifier)
+	name := token.Value[1:]
+
+	if len(p.classScopes) == 0 {
+		panic("private field '#" + name + "' must be declared in an enclosing class")
+	}
+	scope := p.classScopes[len(p.classScopes)-1]
+	scope.referenced = append(scope.referenced, name)
+
+	return &ast.PrivateIdentifier{Start: token.Start, End: token.End, Name: name}
+}
+
+// https://tc39.es/ecma262/#sec-class-definitions-static-semantics-early-errors
+func (p *Parser) declarePrivateName(name string, kind string, static bool) {
+	if name == "constructor" {
+		panic("classes may not have a private element named '#constructor'")
+	}
+
+	if static {
+		kind = "static " + kind
+	}
+
+	scope := p.classScopes[len(p.classScopes)-1]
+	existing, ok := scope.declared[name]
+	switch {
+	case !ok:
+		scope.declared[name] = kind
+	case privateAccessorPairs[existing] == kind:
+		scope.declared[name] = "accessor"
+	default:
+		panic("identifier '#" + name + "' has already been declared")
+	}
+}
+
+// exitClassScope resolves the private names referenced in a class body once
+// all of its elements are declared, leaving the undeclared ones to the
+// enclosing class.
+// https://tc39.es/ecma262/#sec-static-semantics-allprivateidentifiersvalid
+func (p *Parser) exitClassScope() {
+	scope := p.classScopes[len(p.classScopes)-1]
+	p.classScopes = p.classScopes[:len(p.classScopes)-1]
+
+	for _, name := range scope.referenced {
+		if _, ok := scope.declared[name]; ok {
+			continue
+		}
+
+		if len(p.classScopes) == 0 {
+			panic("private field '#" + name + "' must be declared in an enclosing class")
+		}
+		outer := p.classScopes[len(p.classScopes)-1]
+		outer.referenced = append(outer.referenced, name)
+	}
+}
@@ -0,0 +1,79 @@
+package parse
+
+import "gojs/ast"
+
+// checkDeclarations applies the early errors of the declarations of a
+// statement list, returning the names it declares with let, const, class and
+// function declarations. Such a name may be declared only once in the list,
+// and not by a var declaration in it, including those nested in its
+// statements. Functions declared at the top level of a function, script or
+// static block count as var declarations instead.
+// https://tc39.es/ecma262/#sec-block-static-semantics-early-errors
+// https://tc39.es/ecma262/#sec-function-definitions-static-semantics-early-errors
+// https://tc39.es/ecma262/#sec-scripts-static-semantics-early-errors
+func checkDeclarations(statements []ast.Statement, topLevel bool) map[string]bool {
+	lexical := make(map[string]bool)
+	for _, statement := range statements {
+		for _, name := range ast.LexicallyDeclaredNames(statement, topLevel) {
+			if lexical[name] {
+				panic(redeclaration(name))
+			}
+			lexical[name] = true
+		}
+	}
+
+	for _, statement := range statements {
+		for _, name := range ast.VarDeclaredNames(statement, topLevel) {
+			if lexical[name] {
+				panic(redeclaration(name))
+			}
+		}
+	}
+	return lexical
+}
+
+// checkForDeclaration applies the early errors of a let or const declaration
+// in the head of a for statement, whose names may not be declared again by a
+// var declaration in the body.
+// https://tc39.es/ecma262/#sec-for-statement-static-semantics-early-errors
+// https://tc39.es/ecma262/#sec-for-in-and-for-of-statements-static-semantics-early-errors
+func checkForDeclaration(declaration *ast.VariableDeclaration, body ast.Statement) {
+	if declaration.Kind == "var" {
+		return
+	}
+
+	lexical := checkDeclarations([]ast.Statement{declaration}, false)
+	for _, name := range ast.VarDeclaredNames(body, false) {
+		if lexical[name] {
+			panic(redeclaration(name))
+		}
+	}
+}
+
+func redeclaration(name string) string {
+	return "identifier '" + name + "' has already been declared"
+}
+
+// parseSubstatement parses the body of an if, for or with statement, which
+// cannot be a declaration. In sloppy mode code, the consequent of an if
+// statement may be a function declaration, which is scoped as if it were in a
+// block of its own.
+// https://tc39.es/ecma262/#prod-Statement
+// https://tc39.es/ecma262/#sec-functiondeclarations-in-ifstatement-statement-clauses
+func (p *Parser) parseSubstatement(consequent bool) ast.Statement {
+	statement := p.parseStatement()
+	switch n := statement.(type) {
+	case *ast.VariableDeclaration:
+		if n.Kind != "var" {
+			panic("lexical declaration cannot appear in a single-statement context")
+		}
+	case *ast.ClassDeclaration:
+		panic("class declaration cannot appear in a single-statement context")
+	case *ast.FunctionDeclaration:
+		if !consequent || p.strict || n.Generator || n.Async {
+			panic("function declaration cannot appear in a single-statement context")
+		}
+		return &ast.BlockStatement{Start: n.Start, End: n.End, Body: []ast.Statement{n}}
+	}
+	return statement
+}
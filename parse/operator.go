@@ -0,0 +1,71 @@
+package parse
+
+import "gojs/tkn"
+
+type binaryOperator struct {
+	operator   string
+	precedence int
+	logical    bool
+}
+
+// binaryOperators lists the binary and short-circuiting logical operators,
+// where a higher precedence binds more tightly.
+// https://tc39.es/ecma262/#sec-binary-logical-operators
+var binaryOperators = map[tkn.TokenKind]binaryOperator{
+	tkn.TokenKindQuestionQuestion:                  {operator: "??", precedence: 1, logical: true},
+	tkn.TokenKindPipePipe:                          {operator: "||", precedence: 2, logical: true},
+	tkn.TokenKindAmpersandAmpersand:                {operator: "&&", precedence: 3, logical: true},
+	tkn.TokenKindPipe:                              {operator: "|", precedence: 4},
+	tkn.TokenKindCaret:                             {operator: "^", precedence: 5},
+	tkn.TokenKindAmpersand:                         {operator: "&", precedence: 6},
+	tkn.TokenKindEqualEqual:                        {operator: "==", precedence: 7},
+	tkn.TokenKindNotEqual:                          {operator: "!=", precedence: 7},
+	tkn.TokenKindEqualEqualEqual:                   {operator: "===", precedence: 7},
+	tkn.TokenKindNotEqualEqual:                     {operator: "!==", precedence: 7},
+	tkn.TokenKindLessThan:                          {operator: "<", precedence: 8},
+	tkn.TokenKindGreaterThan:                       {operator: ">", precedence: 8},
+	tkn.TokenKindLessThanOrEqual:                   {operator: "<=", precedence: 8},
+	tkn.TokenKindGreaterThanOrEqual:                {operator: ">=", precedence: 8},
+	tkn.TokenKindInstanceof:                        {operator: "instanceof", precedence: 8},
+	tkn.TokenKindIn:                                {operator: "in", precedence: 8},
+	tkn.TokenKindLessThanLessThan:                  {operator: "<<", precedence: 9},
+	tkn.TokenKindGreaterThanGreaterThan:            {operator: ">>", precedence: 9},
+	tkn.TokenKindGreaterThanGreaterThanGreaterThan: {operator: ">>>", precedence: 9},
+	tkn.TokenKindPlus:                              {operator: "+", precedence: 10},
+	tkn.TokenKindMinus:                             {operator: "-", precedence: 10},
+	tkn.TokenKindAsterisk:                          {operator: "*", precedence: 11},
+	tkn.TokenKindSlash:                             {operator: "/", precedence: 11},
+	tkn.TokenKindPercent:                           {operator: "%", precedence: 11},
+	tkn.TokenKindAsteriskAsterisk:                  {operator: "**", precedence: 12},
+}
+
+// https://tc39.es/ecma262/#prod-AssignmentOperator
+var assignmentOperators = map[tkn.TokenKind]string{
+	tkn.TokenKindEqual:                                  "=",
+	tkn.TokenKindPlusEqual:                              "+=",
+	tkn.TokenKindMinusEqual:                             "-=",
+	tkn.TokenKindAsteriskEqual:                          "*=",
+	tkn.TokenKindSlashEqual:                             "/=",
+	tkn.TokenKindPercentEqual:                           "%=",
+	tkn.TokenKindAsteriskAsteriskEqual:                  "**=",
+	tkn.TokenKindLessThanLessThanEqual:                  "<<=",
+	tkn.TokenKindGreaterThanGreaterThanEqual:            ">>=",
+	tkn.TokenKindGreaterThanGreaterThanGreaterThanEqual: ">>>=",
+	tkn.TokenKindAmpersandEqual:                         "&=",
+	tkn.TokenKindPipeEqual:                              "|=",
+	tkn.TokenKindCaretEqual:                             "^=",
+	tkn.TokenKindAmperandAmpersandEqual:                 "&&=",
+	tkn.TokenKindPipePipeEqual:                          "||=",
+	tkn.TokenKindQuestionQuestionEqual:                  "??=",
+}
+
+// https://tc39.es/ecma262/#prod-UnaryExpression
+var unaryOperators = map[tkn.TokenKind]string{
+	tkn.TokenKindDelete:      "delete",
+	tkn.TokenKindVoid:        "void",
+	tkn.TokenKindTypeof:      "typeof",
+	tkn.TokenKindPlus:        "+",
+	tkn.TokenKindMinus:       "-",
+	tkn.TokenKindTilde:       "~",
+	tkn.TokenKindExclamation: "!",
+}
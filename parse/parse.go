@@ -1,55 +1,196 @@
 package parse
 
 import (
+	"sort"
+	"strings"
+
 	"gojs/ast"
+	"gojs/regex"
 	"gojs/tkn"
-	"strconv"
 )
 
 type Parser struct {
-	tokens []tkn.Token
-	offset int
+	// scanner supplies the tokens as they are needed. current is the token
+	// being parsed and previous the one before it; next is the one after it
+	// once peeked is set.
+	scanner                 TokenSource
+	current, previous, next tkn.Token
+	peeked                  bool
+
+	source string
+
+	// retained counts the functions and classes being parsed whose source
+	// text is taken from the tokens, which recorded holds from the start of
+	// the outermost one, when neither the source text nor a spanSource is
+	// given.
+	retained int
+	recorded []tkn.Token
+
+	// comments collects the comments in the trivia of the tokens read.
+	comments []ast.Comment
+
+	// coverInitializedNames counts the shorthand property initializers, as in
+	// {a = 1}, that have not been resolved by reinterpreting their object
+	// literal as an assignment pattern yet.
+	coverInitializedNames int
+
+	// strict is set while parsing strict mode code.
+	strict bool
+
+	// superCall and superProperty are set where the innermost function allows
+	// super calls and super property references, and fieldInitializer in
+	// class field initializers and static blocks.
+	superCall, superProperty, fieldInitializer bool
+
+	// inFunction is set while parsing a function body, where return is
+	// allowed.
+	inFunction bool
+
+	// generator is set while parsing the parameters and body of a generator,
+	// where yield is an operator. parameters is set while parsing parameters,
+	// where yield expressions are not allowed.
+	generator, parameters bool
+
+	// noIn is set while parsing the expression before the first semicolon of
+	// a for statement, where in ends the expression.
+	// https://tc39.es/ecma262/#sec-grammar-notation
+	noIn bool
+
+	// classScopes holds the private names of the enclosing class bodies.
+	classScopes []*classScope
 }
 
-func NewParser(tokens []tkn.Token) *Parser {
-	return &Parser{tokens: tokens, offset: 0}
+type Option func(p *Parser)
+
+// WithSource gives the parser the text the tokens were produced from, from
+// which the source text of functions and classes is recorded. Without it,
+// the parser asks the token source for their text.
+func WithSource(source string) Option {
+	return func(p *Parser) {
+		p.source = source
+	}
+}
+
+// WithStrict parses the program as strict mode code, as if it began with a
+// "use strict" directive.
+func WithStrict() Option {
+	return func(p *Parser) {
+		p.strict = true
+	}
+}
+
+// TokenSource supplies the tokens of a program. *tkn.Tokenizer is a
+// TokenSource that scans them from the source text as they are needed.
+type TokenSource interface {
+	Next() (tkn.Token, error)
+	Rescan(token tkn.Token, goal tkn.Goal) (tkn.Token, error)
+}
+
+// NewParser returns a parser for tokens scanned in advance, which are not
+// rescanned.
+func NewParser(tokens []tkn.Token, options ...Option) *Parser {
+	return NewStreamParser(&tokenList{tokens: tokens}, options...)
+}
+
+// NewStreamParser returns a parser that pulls the tokens from the source as
+// it parses, looking ahead one token at most.
+func NewStreamParser(source TokenSource, options ...Option) *Parser {
+	p := &Parser{scanner: source}
+	for _, option := range options {
+		option(p)
+	}
+	return p
 }
 
 func (p *Parser) Parse() ast.Program {
-	nodes := make([]ast.Node, 0, 10)
+	p.current = p.read()
+	statements, _ := p.parseStatementList(tkn.TokenKindEOF)
+	checkDeclarations(statements, true)
 
-	n := p.parseStatement()
-	for n != nil {
-		nodes = append(nodes, n)
-		n = p.parseStatement()
+	nodes := make([]ast.Node, 0, len(statements))
+	for _, statement := range statements {
+		nodes = append(nodes, statement)
 	}
 
 	return ast.Program{
-		Body: nodes,
+		Body:     nodes,
+		Strict:   p.strict,
+		Comments: p.comments,
 	}
 }
 
+// parseStatementList parses the statements of a script or function body up
+// to the end token. A "use strict" directive in the directive prologue makes
+// the rest of the body strict mode code; whether there was one is reported.
+// https://tc39.es/ecma262/#directive-prologue
+func (p *Parser) parseStatementList(end tkn.TokenKind) ([]ast.Statement, bool) {
+	statements := make([]ast.Statement, 0, 10)
+
+	prologue, useStrict, legacyOctal := true, false, false
+	for !p.match(end) {
+		if p.match(tkn.TokenKindEOF) {
+			p.consume(end)
+		}
+
+		token := p.current
+		statement := p.parseStatement()
+		statements = append(statements, statement)
+
+		if !prologue || !isDirective(statement) {
+			prologue = false
+			continue
+		}
+
+		// Octal escapes in the directives before "use strict" are rejected
+		// as well.
+		legacyOctal = legacyOctal || token.LegacyOctal
+		if isUseStrictDirective(token) {
+			useStrict, p.strict = true, true
+			if legacyOctal {
+				panic("octal escape sequences are not allowed in strict mode")
+			}
+		}
+	}
+	return statements, useStrict
+}
+
 func (p *Parser) parseStatement() ast.Statement {
 	if p.match(tkn.TokenKindEOF) {
 		return nil
 	}
 
+	// A brace at the start of a statement always opens a block, and class a
+	// declaration.
+	if p.match(tkn.TokenKindLeftBrace) {
+		return p.parseBlockStatement()
+	} else if p.match(tkn.TokenKindClass) {
+		return p.parseClassDeclaration()
+	} else if p.matchesLexicalDeclaration() {
+		statement := p.parseVariableDeclaration(false)
+		p.consumeSemicolon()
+		return statement
+	}
+
 	if p.matchesExpression() {
-		return &ast.ExpressionStatement{
+		statement := &ast.ExpressionStatement{
 			Expression: p.parseExpression(),
 		}
+		p.consumeSemicolon()
+		return statement
 	}
 
 	if p.match(tkn.TokenKindFunction) {
 		return p.parseFunction()
 	} else if p.match(tkn.TokenKindVar) {
-		return p.parseVariableDeclaration()
+		statement := p.parseVariableDeclaration(false)
+		p.consumeSemicolon()
+		return statement
+	} else if p.match(tkn.TokenKindWith) {
+		return p.parseWithStatement()
 	} else if p.match(tkn.TokenKindReturn) {
 		return p.parseReturn()
 	} else if p.match(tkn.TokenKindIf) {
 		return p.parseIf()
-	} else if p.match(tkn.TokenKindLeftBrace) {
-		return p.parseBlockStatement()
 	} else if p.match(tkn.TokenKindFor) {
 		return p.parseForStatement()
 	}
@@ -57,49 +198,272 @@ func (p *Parser) parseStatement() ast.Statement {
 	panic("unknown kind: " + p.kind().String())
 }
 
+// consumeSemicolon consumes the semicolon that may end a statement.
+func (p *Parser) consumeSemicolon() {
+	if p.match(tkn.TokenKindSemicolon) {
+		p.consume(tkn.TokenKindSemicolon)
+	}
+}
+
 func (p *Parser) kind() tkn.TokenKind {
-	return p.tokens[p.offset].Kind
+	return p.current.Kind
 }
 
 func (p *Parser) value() string {
-	return p.tokens[p.offset].Value
+	return p.current.Value
 }
 
 func (p *Parser) consume(kind tkn.TokenKind) tkn.Token {
 	if p.kind() != kind {
 		panic("expected kind: " + kind.String() + " but got kind: " + p.kind().String())
 	}
-	p.offset++
-	return p.tokens[p.offset-1]
+
+	p.previous = p.current
+	if p.peeked {
+		p.current, p.peeked = p.next, false
+	} else {
+		p.current = p.read()
+	}
+	return p.previous
+}
+
+// peek returns the token after the current one.
+func (p *Parser) peek() tkn.Token {
+	if !p.peeked {
+		p.next, p.peeked = p.read(), true
+	}
+	return p.next
+}
+
+func (p *Parser) read() tkn.Token {
+	token, err := p.scanner.Next()
+	if err != nil {
+		panic(err.Error())
+	}
+	p.collectComments(token.LeadingTrivia)
+	p.collectComments(token.TrailingTrivia)
+	if p.retained > 0 {
+		p.recorded = append(p.recorded, token)
+	}
+	return token
+}
+
+// rescan scans the current token again with the goal the grammar expects
+// here, where the tokenizer guessed another one.
+// https://tc39.es/ecma262/#sec-ecmascript-language-lexical-grammar
+func (p *Parser) rescan(goal tkn.Goal) {
+	token, err := p.scanner.Rescan(p.current, goal)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	// The comments after the start of the token were read with the tokens
+	// the rescan replaces; those before it are the same.
+	idx := len(p.comments)
+	for idx > 0 && p.comments[idx-1].Start >= token.Start {
+		idx--
+	}
+	p.comments = p.comments[:idx]
+	p.collectComments(token.TrailingTrivia)
+
+	if p.retained > 0 {
+		idx := len(p.recorded)
+		for idx > 0 && p.recorded[idx-1].Start >= token.Start {
+			idx--
+		}
+		p.recorded = append(p.recorded[:idx], token)
+	}
+
+	p.current, p.peeked = token, false
+}
+
+// collectComments adds the comments in the trivia of a token to the comments
+// of the program.
+func (p *Parser) collectComments(trivia []tkn.Trivia) {
+	for _, t := range trivia {
+		switch t.Kind {
+		case tkn.TriviaKindSingleLineComment, tkn.TriviaKindHashbangComment:
+			p.comments = append(p.comments, ast.Comment{Start: t.Start, End: t.End, Text: t.Raw[2:]})
+		case tkn.TriviaKindMultiLineComment:
+			p.comments = append(p.comments, ast.Comment{Start: t.Start, End: t.End, Text: t.Raw[2 : len(t.Raw)-2], Multiline: true})
+		}
+	}
 }
 
 func (p *Parser) match(kind tkn.TokenKind) bool {
 	return p.kind() == kind
 }
 
+// matchContextual reports whether the current token is the contextual
+// keyword.
+func (p *Parser) matchContextual(keyword string) bool {
+	return p.current.IsContextual(keyword)
+}
+
+// spanSource is a TokenSource that can return the source text of the tokens
+// it returned, once asked to retain it.
+type spanSource interface {
+	Retain(offset int)
+	Release()
+	Text(start, end int) string
+}
+
+// retain keeps the source text from the current token on until the returned
+// function is called, for the source text of a function or class starting
+// there. Without the source text or a spanSource, the parser records the
+// tokens to rebuild it from.
+func (p *Parser) retain() func() {
+	if p.source != "" {
+		return func() {}
+	}
+
+	if s, ok := p.scanner.(spanSource); ok {
+		s.Retain(p.current.Start)
+		return s.Release
+	}
+
+	if p.retained == 0 {
+		p.recorded = append(p.recorded[:0], p.current)
+		if p.peeked {
+			p.recorded = append(p.recorded, p.next)
+		}
+	}
+	p.retained++
+	return func() {
+		if p.retained--; p.retained == 0 {
+			p.recorded = p.recorded[:0]
+		}
+	}
+}
+
+func (p *Parser) sourceText(start, end int) string {
+	switch s, ok := p.scanner.(spanSource); {
+	case p.source != "":
+		if len(p.source) < end {
+			return ""
+		}
+		return p.source[start:end]
+	case ok:
+		return s.Text(start, end)
+	default:
+		return tokenText(p.recorded, start, end)
+	}
+}
+
+// tokenText rebuilds the source text between the byte offsets from the
+// tokens in it.
+func tokenText(tokens []tkn.Token, start, end int) string {
+	var b strings.Builder
+	var previous *tkn.Token
+	first := sort.Search(len(tokens), func(idx int) bool {
+		return tokens[idx].Start >= start
+	})
+	for idx := first; idx < len(tokens) && tokens[idx].End <= end; idx++ {
+		token := &tokens[idx]
+		if previous != nil {
+			b.WriteString(separator(previous, token))
+		}
+		b.WriteString(token.Raw)
+		previous = token
+	}
+	return b.String()
+}
+
+// separator returns the source text between two tokens. Their trivia make it
+// exact; without them, the tokens are separated by a line terminator or a
+// space where the source text separated them.
+func separator(previous, token *tkn.Token) string {
+	var b strings.Builder
+	for _, trivia := range previous.TrailingTrivia {
+		b.WriteString(trivia.Raw)
+	}
+	for _, trivia := range token.LeadingTrivia {
+		b.WriteString(trivia.Raw)
+	}
+
+	switch {
+	case b.Len() > 0 || token.Start == previous.End:
+		return b.String()
+	case token.NewlineBefore:
+		return "\n"
+	default:
+		return " "
+	}
+}
+
 func (p *Parser) parseFunction() *ast.FunctionDeclaration {
-	p.consume(tkn.TokenKindFunction)
+	defer p.retain()()
+	defer p.enterFunction(false, false, false)()
+
+	start := p.consume(tkn.TokenKindFunction).Start
+	generator := p.match(tkn.TokenKindAsterisk)
+	if generator {
+		p.consume(tkn.TokenKindAsterisk)
+	}
 	name := p.consume(tkn.TokenKindIdentifier).Value
+	args, body, strict := p.parseFunctionRest(generator)
+
+	// The name is strict mode code when the body is, but yield is only an
+	// operator in the enclosing function.
+	outer := p.strict
+	p.strict = strict
+	p.checkBindingIdentifier(name)
+	p.strict = outer
+
+	return &ast.FunctionDeclaration{
+		Start:      start,
+		End:        body.End,
+		Generator:  generator,
+		Id:         ast.Identifier{Name: name},
+		Parameters: args,
+		Body:       body,
+		Strict:     strict,
+		SourceText: p.sourceText(start, body.End),
+	}
+}
+
+// parseFunctionRest parses the parameter list and the body of a function,
+// reporting whether the function code is strict mode code.
+// https://tc39.es/ecma262/#prod-FormalParameters
+// https://tc39.es/ecma262/#prod-GeneratorBody
+func (p *Parser) parseFunctionRest(generator bool) ([]ast.Pattern, *ast.BlockStatement, bool) {
+	outer, inFunction, outerGenerator, parameters := p.strict, p.inFunction, p.generator, p.parameters
+	defer func() {
+		p.strict, p.inFunction, p.generator, p.parameters = outer, inFunction, outerGenerator, parameters
+	}()
+	p.inFunction, p.generator, p.parameters = true, generator, true
+	defer p.allowIn()()
+
 	p.consume(tkn.TokenKindLeftParen)
 
-	args := make([]ast.Identifier, 0)
-	for p.match(tkn.TokenKindIdentifier) {
-		args = append(args, ast.Identifier{Name: p.consume(tkn.TokenKindIdentifier).Value})
-		if p.match(tkn.TokenKindComma) {
+	args := make([]ast.Pattern, 0)
+	for !p.match(tkn.TokenKindRightParen) {
+		// The rest parameter must be the last one, without a trailing comma.
+		if p.match(tkn.TokenKindSpread) {
+			start := p.consume(tkn.TokenKindSpread).Start
+			argument := p.parseBindingTarget()
+			args = append(args, &ast.RestElement{Start: start, End: p.previous.End, Argument: argument})
+			break
+		}
+
+		args = append(args, p.parseBindingElement())
+		if !p.match(tkn.TokenKindRightParen) {
 			p.consume(tkn.TokenKindComma)
 		}
 	}
 	p.consume(tkn.TokenKindRightParen)
+	p.parameters = false
 
-	return &ast.FunctionDeclaration{
-		Id:         ast.Identifier{Name: name},
-		Parameters: args,
-		Body:       p.parseBlockStatement(),
-	}
+	start := p.consume(tkn.TokenKindLeftBrace).Start
+	statements, useStrict := p.parseStatementList(tkn.TokenKindRightBrace)
+	end := p.consume(tkn.TokenKindRightBrace).End
+
+	p.checkParameters(args, useStrict, checkDeclarations(statements, true))
+	return args, &ast.BlockStatement{Start: start, End: end, Body: statements}, p.strict
 }
 
 func (p *Parser) parseBlockStatement() *ast.BlockStatement {
-	p.consume(tkn.TokenKindLeftBrace)
+	start := p.consume(tkn.TokenKindLeftBrace).Start
 
 	statements := make([]ast.Statement, 0, 10)
 	for !p.match(tkn.TokenKindRightBrace) {
@@ -107,25 +471,73 @@ func (p *Parser) parseBlockStatement() *ast.BlockStatement {
 		statement := node.(ast.Statement)
 		statements = append(statements, statement)
 	}
-	p.consume(tkn.TokenKindRightBrace)
+	end := p.consume(tkn.TokenKindRightBrace).End
+	checkDeclarations(statements, false)
 
 	return &ast.BlockStatement{
-		Body: statements,
+		Start: start,
+		End:   end,
+		Body:  statements,
 	}
 }
 
-func (p *Parser) parseForStatement() *ast.ForStatement {
-	p.consume(tkn.TokenKindFor)
+// parseForStatement parses a for statement, which is a for-in or for-of
+// statement when in or of follows a single binding or an assignment target.
+// The in operator is not allowed in the expression before the first
+// semicolon, unless it is nested in brackets.
+// https://tc39.es/ecma262/#prod-ForStatement
+// https://tc39.es/ecma262/#prod-ForInOfStatement
+func (p *Parser) parseForStatement() ast.Statement {
+	start := p.consume(tkn.TokenKindFor).Start
 	p.consume(tkn.TokenKindLeftParen)
-	init := p.parseStatement()
+
+	p.noIn = true
+	var init ast.Statement
+	if p.match(tkn.TokenKindVar) || p.matchesLexicalDeclaration() {
+		declaration := p.parseVariableDeclaration(true)
+		p.noIn = false
+
+		if p.matchesForInOf() {
+			if len(declaration.Declarations) != 1 {
+				panic("for-in and for-of loop variable declarations must have a single binding")
+			}
+			if declaration.Declarations[0].Init != nil {
+				panic("for-in and for-of loop variable declarations may not have an initializer")
+			}
+			return p.parseForInOfStatement(start, declaration)
+		}
+		init = declaration
+	} else {
+		pending := p.coverInitializedNames
+		exprStart := p.current.Start
+		expr := p.parseAssignmentExpressionCover()
+		p.noIn = false
+
+		if p.matchesForInOf() {
+			target := toAssignmentPattern(expr)
+			p.coverInitializedNames = pending
+			p.checkAssignmentTarget(target)
+			return p.parseForInOfStatement(start, target)
+		}
+
+		if p.coverInitializedNames != pending {
+			panic("invalid shorthand property initializer")
+		}
+		init = &ast.ExpressionStatement{Expression: p.parseSequenceExpression(exprStart, expr)}
+	}
 	p.consume(tkn.TokenKindSemicolon)
 	test := p.parseExpression()
 	p.consume(tkn.TokenKindSemicolon)
 	update := p.parseExpression()
 	p.consume(tkn.TokenKindRightParen)
-	body := p.parseStatement()
+	body := p.parseSubstatement(false)
+	if declaration, ok := init.(*ast.VariableDeclaration); ok {
+		checkForDeclaration(declaration, body)
+	}
 
 	return &ast.ForStatement{
+		Start:  start,
+		End:    p.previous.End,
 		Init:   init,
 		Test:   test,
 		Update: update,
@@ -133,24 +545,140 @@ func (p *Parser) parseForStatement() *ast.ForStatement {
 	}
 }
 
-func (p *Parser) parseVariableDeclaration() *ast.VariableDeclaration {
-	p.consume(tkn.TokenKindVar)
-	name := p.consume(tkn.TokenKindIdentifier).Value
-	p.consume(tkn.TokenKindEqual)
-	init := p.parseExpression()
-	return &ast.VariableDeclaration{
-		Declarations: []*ast.VariableDeclarator{
-			{
-				Id:   &ast.Identifier{Name: name},
-				Init: init,
-			},
-		},
-		Kind: "var",
+// parseForInOfStatement parses the rest of a for-in or for-of statement from
+// the in or of that follows its left-hand side. The right-hand side of for-of
+// is an assignment expression, so it cannot be a comma expression.
+// https://tc39.es/ecma262/#prod-ForInOfStatement
+func (p *Parser) parseForInOfStatement(start int, left ast.Node) ast.Statement {
+	of := !p.match(tkn.TokenKindIn)
+	p.consume(p.kind())
+
+	var right ast.Expression
+	if of {
+		right = p.parseAssignmentExpression()
+	} else {
+		right = p.parseExpression()
+	}
+	p.consume(tkn.TokenKindRightParen)
+	body := p.parseSubstatement(false)
+	end := p.previous.End
+	if declaration, ok := left.(*ast.VariableDeclaration); ok {
+		checkForDeclaration(declaration, body)
+	}
+
+	if of {
+		return &ast.ForOfStatement{Start: start, End: end, Left: left, Right: right, Body: body}
+	}
+	return &ast.ForInStatement{Start: start, End: end, Left: left, Right: right, Body: body}
+}
+
+// allowIn lifts noIn for an expression nested in brackets, returning a
+// function that restores it.
+func (p *Parser) allowIn() func() {
+	noIn := p.noIn
+	p.noIn = false
+	return func() {
+		p.noIn = noIn
+	}
+}
+
+func (p *Parser) matchesForInOf() bool {
+	return p.match(tkn.TokenKindIn) || p.matchContextual("of")
+}
+
+// https://tc39.es/ecma262/#prod-WithStatement
+// https://tc39.es/ecma262/#sec-with-statement-static-semantics-early-errors
+func (p *Parser) parseWithStatement() *ast.WithStatement {
+	start := p.consume(tkn.TokenKindWith).Start
+	if p.strict {
+		panic("strict mode code may not include a with statement")
 	}
 
+	p.consume(tkn.TokenKindLeftParen)
+	object := p.parseExpression()
+	p.consume(tkn.TokenKindRightParen)
+	body := p.parseSubstatement(false)
+
+	return &ast.WithStatement{Start: start, End: p.previous.End, Object: object, Body: body}
+}
+
+// matchesLexicalDeclaration reports whether a let or const declaration
+// starts at the current token. let is an identifier elsewhere, so it starts a
+// declaration only when a binding follows it.
+// https://tc39.es/ecma262/#prod-LexicalDeclaration
+func (p *Parser) matchesLexicalDeclaration() bool {
+	if p.match(tkn.TokenKindConst) {
+		return true
+	}
+	if !p.matchContextual("let") {
+		return false
+	}
+
+	switch p.peek().Kind {
+	case tkn.TokenKindIdentifier, tkn.TokenKindLeftSquareBracket, tkn.TokenKindLeftBrace:
+		return true
+	}
+	return false
+}
+
+// parseVariableDeclaration parses a var, let or const declaration. In the
+// head of a for statement, a declaration may go without an initializer when
+// it is bound by in or of instead, which const and destructuring
+// declarations otherwise require.
+// https://tc39.es/ecma262/#prod-VariableStatement
+// https://tc39.es/ecma262/#prod-LexicalDeclaration
+func (p *Parser) parseVariableDeclaration(forHead bool) *ast.VariableDeclaration {
+	start, kind := p.current.Start, "var"
+	if p.match(tkn.TokenKindConst) {
+		kind = "const"
+	} else if p.matchContextual("let") {
+		kind = "let"
+	}
+	p.consume(p.kind())
+
+	var declarations []*ast.VariableDeclarator
+	for {
+		declarator := &ast.VariableDeclarator{Start: p.current.Start, Id: p.parseBindingTarget()}
+		if kind != "var" {
+			for _, name := range ast.BoundNames(declarator.Id) {
+				if name == "let" {
+					panic("let is disallowed as a lexically bound name")
+				}
+			}
+		}
+
+		if p.match(tkn.TokenKindEqual) {
+			p.consume(tkn.TokenKindEqual)
+			declarator.Init = p.parseAssignmentExpression()
+		} else if !(forHead && p.matchesForInOf()) {
+			if kind == "const" {
+				panic("missing initializer in const declaration")
+			}
+			if _, ok := declarator.Id.(*ast.Identifier); !ok {
+				panic("missing initializer in destructuring declaration")
+			}
+		}
+		declarator.End = p.previous.End
+		declarations = append(declarations, declarator)
+
+		if !p.match(tkn.TokenKindComma) {
+			break
+		}
+		p.consume(tkn.TokenKindComma)
+	}
+
+	return &ast.VariableDeclaration{
+		Start:        start,
+		End:          p.previous.End,
+		Declarations: declarations,
+		Kind:         kind,
+	}
 }
 
 func (p *Parser) parseReturn() *ast.ReturnStatement {
+	if !p.inFunction {
+		panic("illegal return statement")
+	}
 	p.consume(tkn.TokenKindReturn)
 
 	var expr ast.Expression
@@ -171,7 +699,7 @@ func (p *Parser) parseIf() *ast.IfStatement {
 	p.consume(tkn.TokenKindLeftParen)
 	test := p.parseExpression()
 	p.consume(tkn.TokenKindRightParen)
-	consequent := p.parseStatement()
+	consequent := p.parseSubstatement(true)
 
 	return &ast.IfStatement{
 		Test:       test,
@@ -179,108 +707,739 @@ func (p *Parser) parseIf() *ast.IfStatement {
 	}
 }
 
-func (p *Parser) parseCallExpression(identifier *ast.Identifier) *ast.CallExpression {
+func (p *Parser) parseCallExpression(callee ast.Expression) *ast.CallExpression {
+	return &ast.CallExpression{
+		Callee:    callee,
+		Arguments: p.parseArguments(),
+	}
+}
+
+// https://tc39.es/ecma262/#prod-Arguments
+func (p *Parser) parseArguments() []ast.Expression {
+	defer p.allowIn()()
+
 	p.consume(tkn.TokenKindLeftParen)
 	args := make([]ast.Expression, 0)
 	for !p.match(tkn.TokenKindRightParen) {
-		args = append(args, p.parseExpression())
-		if p.match(tkn.TokenKindComma) {
+		if p.match(tkn.TokenKindSpread) {
+			start := p.consume(tkn.TokenKindSpread).Start
+			argument := p.parseAssignmentExpression()
+			args = append(args, &ast.SpreadElement{Start: start, End: p.previous.End, Argument: argument})
+		} else {
+			args = append(args, p.parseAssignmentExpression())
+		}
+
+		if !p.match(tkn.TokenKindRightParen) {
 			p.consume(tkn.TokenKindComma)
 		}
 	}
 	p.consume(tkn.TokenKindRightParen)
+	return args
+}
 
-	return &ast.CallExpression{
-		Callee:    identifier,
-		Arguments: args,
+// https://tc39.es/ecma262/#prod-Expression
+func (p *Parser) parseExpression() ast.Expression {
+	start := p.current.Start
+	return p.parseSequenceExpression(start, p.parseAssignmentExpression())
+}
+
+// parseSequenceExpression parses the rest of a comma expression whose first
+// expression has been parsed.
+// https://tc39.es/ecma262/#sec-comma-operator
+func (p *Parser) parseSequenceExpression(start int, expr ast.Expression) ast.Expression {
+	if !p.match(tkn.TokenKindComma) {
+		return expr
 	}
+
+	expressions := []ast.Expression{expr}
+	for p.match(tkn.TokenKindComma) {
+		p.consume(tkn.TokenKindComma)
+		expressions = append(expressions, p.parseAssignmentExpression())
+	}
+	return &ast.SequenceExpression{Start: start, End: p.previous.End, Expressions: expressions}
 }
 
-func (p *Parser) parseExpression() ast.Expression {
-	expr := p.parsePrimaryExpression()
+// https://tc39.es/ecma262/#prod-AssignmentExpression
+func (p *Parser) parseAssignmentExpression() ast.Expression {
+	pending := p.coverInitializedNames
+	expr := p.parseAssignmentExpressionCover()
+
+	// https://tc39.es/ecma262/#sec-object-initializer-static-semantics-early-errors
+	if p.coverInitializedNames != pending {
+		panic("invalid shorthand property initializer")
+	}
+	return expr
+}
+
+// parseAssignmentExpressionCover parses an assignment expression that may
+// still be reinterpreted as an assignment pattern, as the elements of object
+// and array literals may, so shorthand property initializers are left for the
+// enclosing literal to resolve.
+func (p *Parser) parseAssignmentExpressionCover() ast.Expression {
+	if p.generator && p.matchContextual("yield") {
+		return p.parseYieldExpression()
+	}
+
+	pending := p.coverInitializedNames
+	start := p.current.Start
+	lhs := p.parseBinaryExpression(0)
+	if p.match(tkn.TokenKindQuestion) {
+		return p.parseConditionalExpression(start, lhs)
+	}
+
+	operator, ok := assignmentOperators[p.kind()]
+	if !ok {
+		return lhs
+	}
+	p.consume(p.kind())
+
+	switch lhs.(type) {
+	case *ast.ObjectExpression, *ast.ArrayExpression:
+		if operator == "=" {
+			lhs = toAssignmentPattern(lhs)
+			p.coverInitializedNames = pending
+		}
+	}
+
+	// Parenthesized patterns are not destructured, so only a simple target
+	// may be wrapped in parentheses.
+	// https://tc39.es/ecma262/#sec-static-semantics-assignmenttargettype
+	_, parenthesized := lhs.(*ast.ParenthesizedExpression)
+	if _, ok := lhs.(ast.Pattern); !ok || ((operator != "=" || parenthesized) && !isSimpleAssignmentTarget(lhs)) {
+		panic("invalid left-hand side in assignment")
+	}
+	p.checkAssignmentTarget(lhs)
+
+	return &ast.AssignmentExpression{Left: lhs, Right: p.parseAssignmentExpression(), Operator: operator}
+}
+
+// The argument of yield must begin on the same line, and is optional unless
+// the yield delegates.
+// https://tc39.es/ecma262/#prod-YieldExpression
+func (p *Parser) parseYieldExpression() *ast.YieldExpression {
+	if p.parameters {
+		panic("yield expression not allowed in formal parameter")
+	}
+
+	token := p.consume(tkn.TokenKindIdentifier)
+	expr := &ast.YieldExpression{Start: token.Start, End: token.End}
+	if p.current.NewlineBefore {
+		return expr
+	}
+
+	if p.match(tkn.TokenKindAsterisk) {
+		p.consume(tkn.TokenKindAsterisk)
+		expr.Delegate = true
+		expr.Argument = p.parseAssignmentExpression()
+	} else if p.matchesExpression() {
+		expr.Argument = p.parseAssignmentExpression()
+	}
+	expr.End = p.previous.End
+	return expr
+}
+
+// isPrivateMember reports whether the expression is a reference to a private
+// member, including the last member of an optional chain.
+func isPrivateMember(expr ast.Expression) bool {
+	expr = ast.Unparenthesized(expr)
+	if chain, ok := expr.(*ast.ChainExpression); ok {
+		expr = chain.Expression
+	}
+
+	member, ok := expr.(*ast.MemberExpression)
+	if !ok {
+		return false
+	}
+	_, private := member.Property.(*ast.PrivateIdentifier)
+	return private
+}
+
+func isSimpleAssignmentTarget(expr ast.Expression) bool {
+	switch ast.Unparenthesized(expr).(type) {
+	case *ast.Identifier, *ast.MemberExpression:
+		return true
+	default:
+		return false
+	}
+}
+
+// https://tc39.es/ecma262/#prod-ConditionalExpression
+func (p *Parser) parseConditionalExpression(start int, test ast.Expression) *ast.ConditionalExpression {
+	p.consume(tkn.TokenKindQuestion)
+	restore := p.allowIn()
+	consequent := p.parseAssignmentExpression()
+	restore()
+	p.consume(tkn.TokenKindColon)
+	alternate := p.parseAssignmentExpression()
+
+	return &ast.ConditionalExpression{
+		Start:      start,
+		End:        p.previous.End,
+		Test:       test,
+		Consequent: consequent,
+		Alternate:  alternate,
+	}
+}
+
+// parseBinaryExpression parses binary and short-circuiting logical
+// expressions by precedence climbing, combining operators that bind at least
+// as tightly as minPrecedence.
+// https://tc39.es/ecma262/#prod-ShortCircuitExpression
+func (p *Parser) parseBinaryExpression(minPrecedence int) ast.Expression {
+	// A private name is only an expression on the left of in, as in #x in o.
+	// https://tc39.es/ecma262/#prod-RelationalExpression
+	var lhs ast.Expression
+	if p.match(tkn.TokenKindPrivateIdentifier) && p.peek().Kind == tkn.TokenKindIn {
+		lhs = p.parsePrivateIdentifier()
+	} else {
+		lhs = p.parseUnaryExpression()
+	}
+
+	// https://tc39.es/ecma262/#prod-CoalesceExpression
+	// ?? cannot be mixed with && or || without parentheses.
+	coalesce, logical := false, false
+	for {
+		if p.match(tkn.TokenKindRegularExpressionLiteral) {
+			p.rescan(tkn.GoalDiv)
+		}
+
+		op, ok := binaryOperators[p.kind()]
+		if !ok || op.precedence < minPrecedence || (p.noIn && op.operator == "in") {
+			if _, private := lhs.(*ast.PrivateIdentifier); private {
+				panic("unexpected private name #" + lhs.(*ast.PrivateIdentifier).Name)
+			}
+			return lhs
+		}
+		p.consume(p.kind())
+
+		if op.operator == "??" {
+			coalesce = true
+		} else if op.logical {
+			logical = true
+		}
+
+		if coalesce && logical {
+			panic("cannot mix ?? with && or || without parentheses")
+		}
+
+		var rhs ast.Expression
+		switch op.operator {
+		case "**":
+			rhs = p.parseBinaryExpression(op.precedence)
+		case "??":
+			rhs = p.parseBinaryExpression(binaryOperators[tkn.TokenKindPipe].precedence)
+		default:
+			rhs = p.parseBinaryExpression(op.precedence + 1)
+		}
+
+		if op.logical {
+			lhs = &ast.LogicalExpression{Left: lhs, Right: rhs, Operator: op.operator}
+		} else {
+			lhs = &ast.BinaryExpression{Left: lhs, Right: rhs, Operator: op.operator}
+		}
+	}
+}
+
+// https://tc39.es/ecma262/#prod-UnaryExpression
+func (p *Parser) parseUnaryExpression() ast.Expression {
+	if p.match(tkn.TokenKindPlusPlus) || p.match(tkn.TokenKindMinusMinus) {
+		return p.parsePrefixExpression()
+	}
+
+	operator, ok := unaryOperators[p.kind()]
+	if !ok {
+		return p.parsePostfixExpression()
+	}
+	p.consume(p.kind())
+
+	expr := &ast.UnaryExpression{Operator: operator, Argument: p.parseUnaryExpression()}
+
+	// https://tc39.es/ecma262/#sec-delete-operator-static-semantics-early-errors
+	if _, ok := ast.Unparenthesized(expr.Argument).(*ast.Identifier); ok && p.strict && operator == "delete" {
+		panic("delete of an unqualified identifier in strict mode")
+	}
+	if isPrivateMember(expr.Argument) && operator == "delete" {
+		panic("private fields can not be deleted")
+	}
+
+	// https://tc39.es/ecma262/#prod-ExponentiationExpression
+	if p.match(tkn.TokenKindAsteriskAsterisk) {
+		panic("unary operator used immediately before exponentiation expression")
+	}
+	return expr
+}
+
+// https://tc39.es/ecma262/#prod-UpdateExpression
+func (p *Parser) parsePrefixExpression() ast.Expression {
+	operator := p.consume(p.kind())
+	argument := p.parseUnaryExpression()
+	if !isSimpleAssignmentTarget(argument) {
+		panic("invalid left-hand side expression in prefix operation")
+	}
+	p.checkAssignmentTarget(argument)
+
+	return &ast.UpdateExpression{Argument: argument, Operator: operatorText(operator), Prefix: true}
+}
+
+// https://tc39.es/ecma262/#prod-UpdateExpression
+func (p *Parser) parsePostfixExpression() ast.Expression {
+	lhs := p.parseLeftHandSideExpression()
+
+	// No LineTerminator is allowed before a postfix operator, so it starts a new
+	// statement instead.
+	// https://tc39.es/ecma262/#sec-rules-of-automatic-semicolon-insertion
+	if (!p.match(tkn.TokenKindPlusPlus) && !p.match(tkn.TokenKindMinusMinus)) || p.current.NewlineBefore {
+		return lhs
+	}
+
+	if !isSimpleAssignmentTarget(lhs) {
+		panic("invalid left-hand side expression in postfix operation")
+	}
+	p.checkAssignmentTarget(lhs)
+
+	operator := p.consume(p.kind())
+	return &ast.UpdateExpression{Argument: lhs, Operator: operatorText(operator)}
+}
+
+func operatorText(token tkn.Token) string {
+	if token.Kind == tkn.TokenKindPlusPlus {
+		return "++"
+	}
+	return "--"
+}
+
+// https://tc39.es/ecma262/#prod-LeftHandSideExpression
+func (p *Parser) parseLeftHandSideExpression() ast.Expression {
+	start := p.current.Start
+
+	var expr ast.Expression
+	if p.match(tkn.TokenKindNew) {
+		expr = p.parseNewExpression()
+	} else {
+		expr = p.parsePrimaryExpression()
+	}
+
+	optional := false
 	for p.matchesSecondaryExpression() {
+		if p.match(tkn.TokenKindQuestionPeriod) {
+			if _, ok := expr.(*ast.Super); ok {
+				panic("'super' keyword unexpected here")
+			}
+			expr = p.parseOptionalExpression(expr)
+			optional = true
+			continue
+		}
+
+		if optional && p.matchesTemplate() {
+			panic("invalid tagged template on optional chain")
+		}
+		if _, ok := expr.(*ast.Super); ok && p.matchesTemplate() {
+			panic("'super' keyword unexpected here")
+		}
 		expr = p.parseSecondaryExpression(expr)
 	}
+
+	if optional {
+		return &ast.ChainExpression{Start: start, End: p.previous.End, Expression: expr}
+	}
 	return expr
 }
 
+// parseNewExpression parses new with the member expression it constructs.
+// Calls and optional chains end the member expression, so new f().g calls g
+// on the constructed object.
+// https://tc39.es/ecma262/#prod-NewExpression
+func (p *Parser) parseNewExpression() *ast.NewExpression {
+	start := p.consume(tkn.TokenKindNew).Start
+
+	var callee ast.Expression
+	if p.match(tkn.TokenKindNew) {
+		callee = p.parseNewExpression()
+	} else {
+		callee = p.parsePrimaryExpression()
+	}
+
+	for p.matchesSecondaryExpression() && !p.match(tkn.TokenKindLeftParen) {
+		if p.match(tkn.TokenKindQuestionPeriod) {
+			panic("invalid optional chain from new expression")
+		}
+		callee = p.parseSecondaryExpression(callee)
+	}
+	if _, ok := callee.(*ast.Super); ok {
+		panic("'super' keyword unexpected here")
+	}
+
+	args := make([]ast.Expression, 0)
+	if p.match(tkn.TokenKindLeftParen) {
+		args = p.parseArguments()
+	}
+	return &ast.NewExpression{Start: start, End: p.previous.End, Callee: callee, Arguments: args}
+}
+
+// parseOptionalExpression parses the optional member access or call that
+// follows ?.
+// https://tc39.es/ecma262/#prod-OptionalChain
+func (p *Parser) parseOptionalExpression(lhs ast.Expression) ast.Expression {
+	p.consume(tkn.TokenKindQuestionPeriod)
+
+	switch {
+	case p.match(tkn.TokenKindLeftParen):
+		call := p.parseCallExpression(lhs)
+		call.Optional = true
+		return call
+	case p.match(tkn.TokenKindLeftSquareBracket):
+		restore := p.allowIn()
+		p.consume(tkn.TokenKindLeftSquareBracket)
+		property := p.parseExpression()
+		p.consume(tkn.TokenKindRightSquareBracket)
+		restore()
+		return &ast.MemberExpression{Object: lhs, Property: property, Computed: true, Optional: true}
+	case p.match(tkn.TokenKindPrivateIdentifier):
+		return &ast.MemberExpression{Object: lhs, Property: p.parsePrivateIdentifier(), Optional: true}
+	case p.matchesIdentifierName():
+		property := p.consume(p.kind()).Value
+		return &ast.MemberExpression{Object: lhs, Property: &ast.Identifier{Name: property}, Optional: true}
+	default:
+		panic("expected property name but got kind: " + p.kind().String())
+	}
+}
+
 func (p *Parser) parsePrimaryExpression() ast.Expression {
 	if p.match(tkn.TokenKindLeftParen) {
-		p.consume(tkn.TokenKindLeftParen)
+		restore := p.allowIn()
+		defer restore()
+
+		start := p.consume(tkn.TokenKindLeftParen).Start
 		expr := p.parseExpression()
-		p.consume(tkn.TokenKindRightParen)
-		return expr
+		end := p.consume(tkn.TokenKindRightParen).End
+		return &ast.ParenthesizedExpression{Start: start, End: end, Expression: expr}
 	} else if p.match(tkn.TokenKindIdentifier) {
-		return &ast.Identifier{Name: p.consume(tkn.TokenKindIdentifier).Value}
-	} else if p.match(tkn.TokenKindIntLiteral) {
-		value, _ := strconv.Atoi(p.consume(tkn.TokenKindIntLiteral).Value)
-		return &ast.IntLiteral{Value: value}
+		name := p.consume(tkn.TokenKindIdentifier).Value
+		p.checkIdentifierReference(name)
+		return &ast.Identifier{Name: name}
+	} else if p.match(tkn.TokenKindThis) {
+		token := p.consume(tkn.TokenKindThis)
+		return &ast.ThisExpression{Start: token.Start, End: token.End}
+	} else if p.match(tkn.TokenKindTrue) || p.match(tkn.TokenKindFalse) {
+		token := p.consume(p.kind())
+		return &ast.BooleanLiteral{Start: token.Start, End: token.End, Value: token.Kind == tkn.TokenKindTrue}
+	} else if p.match(tkn.TokenKindNull) {
+		token := p.consume(tkn.TokenKindNull)
+		return &ast.NullLiteral{Start: token.Start, End: token.End}
+	} else if p.match(tkn.TokenKindSuper) {
+		return p.parseSuper()
+	} else if p.match(tkn.TokenKindClass) {
+		return p.parseClassExpression()
+	} else if p.match(tkn.TokenKindNumericLiteral) {
+		token := p.consume(tkn.TokenKindNumericLiteral)
+		p.checkLegacyOctal(token)
+		return &ast.NumericLiteral{Value: tkn.NumericValue(token.Value)}
+	} else if p.match(tkn.TokenKindBigIntLiteral) {
+		token := p.consume(tkn.TokenKindBigIntLiteral)
+		return &ast.BigIntLiteral{Start: token.Start, End: token.End, Value: tkn.BigIntValue(token.Value)}
+	} else if p.match(tkn.TokenKindStringLiteral) {
+		token := p.consume(tkn.TokenKindStringLiteral)
+		p.checkLegacyOctal(token)
+		return &ast.StringLiteral{Start: token.Start, End: token.End, Value: token.Value}
+	} else if p.match(tkn.TokenKindRegularExpressionLiteral) {
+		return p.parseRegExpLiteral()
+	} else if p.match(tkn.TokenKindSlash) || p.match(tkn.TokenKindSlashEqual) {
+		p.rescan(tkn.GoalRegExp)
+		return p.parseRegExpLiteral()
+	} else if p.matchesTemplate() {
+		return p.parseTemplateLiteral(false)
 	} else if p.match(tkn.TokenKindLeftSquareBracket) {
-		var elements []ast.Expression
-		p.consume(tkn.TokenKindLeftSquareBracket)
-		for !p.match(tkn.TokenKindRightSquareBracket) {
-			elements = append(elements, p.parseExpression())
-			if p.match(tkn.TokenKindComma) {
-				p.consume(tkn.TokenKindComma)
+		return p.parseArrayLiteral()
+	} else if p.match(tkn.TokenKindLeftBrace) {
+		return p.parseObjectLiteral()
+	} else {
+		panic("unexpected token: " + p.kind().String())
+	}
+}
+
+// https://tc39.es/ecma262/#prod-ArrayLiteral
+func (p *Parser) parseArrayLiteral() *ast.ArrayExpression {
+	defer p.allowIn()()
+
+	start := p.consume(tkn.TokenKindLeftSquareBracket).Start
+
+	var elements []ast.Expression
+	for !p.match(tkn.TokenKindRightSquareBracket) {
+		if p.match(tkn.TokenKindComma) {
+			p.consume(tkn.TokenKindComma)
+			elements = append(elements, nil)
+			continue
+		}
+
+		if p.match(tkn.TokenKindSpread) {
+			elements = append(elements, p.parseSpreadElement())
+		} else {
+			elements = append(elements, p.parseAssignmentExpressionCover())
+		}
+
+		if !p.match(tkn.TokenKindRightSquareBracket) {
+			p.consume(tkn.TokenKindComma)
+		}
+	}
+	end := p.consume(tkn.TokenKindRightSquareBracket).End
+
+	return &ast.ArrayExpression{Start: start, End: end, Elements: elements}
+}
+
+// https://tc39.es/ecma262/#prod-SpreadElement
+func (p *Parser) parseSpreadElement() *ast.SpreadElement {
+	start := p.consume(tkn.TokenKindSpread).Start
+	argument := p.parseAssignmentExpressionCover()
+	return &ast.SpreadElement{Start: start, End: p.previous.End, Argument: argument}
+}
+
+// https://tc39.es/ecma262/#prod-ObjectLiteral
+func (p *Parser) parseObjectLiteral() *ast.ObjectExpression {
+	defer p.allowIn()()
+
+	start := p.consume(tkn.TokenKindLeftBrace).Start
+
+	var properties []ast.Node
+	hasProto := false
+	for !p.match(tkn.TokenKindRightBrace) {
+		property := p.parsePropertyDefinition()
+		if property, ok := property.(*ast.Property); ok && property.IsProtoSetter() {
+			if hasProto {
+				panic("duplicate __proto__ fields are not allowed in object literals")
 			}
+			hasProto = true
 		}
+		properties = append(properties, property)
+
+		if !p.match(tkn.TokenKindRightBrace) {
+			p.consume(tkn.TokenKindComma)
+		}
+	}
+	end := p.consume(tkn.TokenKindRightBrace).End
+
+	return &ast.ObjectExpression{Start: start, End: end, Properties: properties}
+}
+
+// https://tc39.es/ecma262/#prod-PropertyDefinition
+func (p *Parser) parsePropertyDefinition() ast.Node {
+	if p.match(tkn.TokenKindSpread) {
+		return p.parseSpreadElement()
+	}
+
+	// Only methods and accessors have source text, which starts at the key.
+	release := p.retain()
+	start := p.current.Start
+
+	generator := p.match(tkn.TokenKindAsterisk)
+	if generator {
+		p.consume(tkn.TokenKindAsterisk)
+	}
+
+	// get and set only start an accessor when a property name follows.
+	kind := "init"
+	if !generator && (p.matchContextual("get") || p.matchContextual("set")) && p.matchesPropertyName(p.peek().Kind) {
+		kind = p.consume(tkn.TokenKindIdentifier).Value
+	}
+
+	identifier := p.match(tkn.TokenKindIdentifier)
+	key, computed := p.parsePropertyName()
+	property := &ast.Property{Start: start, Key: key, Kind: kind, Computed: computed}
+
+	switch {
+	case kind != "init" || generator || p.match(tkn.TokenKindLeftParen):
+		property.Method = kind == "init"
+		property.Value = p.parseMethod(start, kind, false, generator)
+		release()
+	case p.match(tkn.TokenKindColon):
+		release()
+		p.consume(tkn.TokenKindColon)
+		property.Value = p.parseAssignmentExpressionCover()
+	case identifier:
+		release()
+		name := key.(*ast.Identifier)
+		p.checkIdentifierReference(name.Name)
+		property.Shorthand = true
+		property.Value = &ast.Identifier{Start: name.Start, End: name.End, Name: name.Name}
+
+		// https://tc39.es/ecma262/#prod-CoverInitializedName
+		if p.match(tkn.TokenKindEqual) {
+			p.consume(tkn.TokenKindEqual)
+			p.coverInitializedNames++
+			property.Value = &ast.AssignmentPattern{
+				Start: name.Start,
+				Left:  property.Value.(*ast.Identifier),
+				Right: p.parseAssignmentExpression(),
+				End:   p.previous.End,
+			}
+		}
+	default:
+		panic("expected ':' after property name but got kind: " + p.kind().String())
+	}
+
+	property.End = p.previous.End
+	return property
+}
+
+// parseMethod parses the parameters and body of a method or accessor, which
+// may reference super properties, and call super when superCall is set.
+// https://tc39.es/ecma262/#prod-MethodDefinition
+// https://tc39.es/ecma262/#prod-GeneratorMethod
+func (p *Parser) parseMethod(start int, kind string, superCall, generator bool) *ast.FunctionExpression {
+	defer p.enterFunction(superCall, true, false)()
+
+	args, body, strict := p.parseFunctionRest(generator)
+	if kind == "get" && len(args) != 0 {
+		panic("getter must not have any formal parameters")
+	}
+	if kind == "set" && len(args) != 1 {
+		panic("setter must have exactly one formal parameter")
+	}
+	if kind == "set" {
+		if _, rest := args[0].(*ast.RestElement); rest {
+			panic("setter function argument must not be a rest parameter")
+		}
+	}
+
+	return &ast.FunctionExpression{
+		Start:      start,
+		End:        body.End,
+		Generator:  generator,
+		Parameters: args,
+		Body:       body,
+		Strict:     strict,
+		SourceText: p.sourceText(start, body.End),
+	}
+}
+
+// parsePropertyName parses a literal or computed property name. Literal
+// names are an *ast.Identifier for any IdentifierName, including reserved
+// words, or a string or numeric literal.
+// https://tc39.es/ecma262/#prod-PropertyName
+func (p *Parser) parsePropertyName() (key ast.Expression, computed bool) {
+	defer p.allowIn()()
+
+	token := p.current
+	switch {
+	case p.match(tkn.TokenKindLeftSquareBracket):
+		p.consume(tkn.TokenKindLeftSquareBracket)
+		key = p.parseAssignmentExpression()
 		p.consume(tkn.TokenKindRightSquareBracket)
-		return &ast.ArrayExpression{Elements: elements}
-	} else if p.match(tkn.TokenKindLeftBrace) {
-		var properties []ast.Property
-		p.consume(tkn.TokenKindLeftBrace)
-		for !p.match(tkn.TokenKindRightBrace) {
-			key := p.parseExpression()
-			p.consume(tkn.TokenKindColon)
-			value := p.parseExpression()
-			properties = append(properties, ast.Property{Key: key, Value: value})
-		}
-		p.consume(tkn.TokenKindRightBrace)
-		return &ast.ObjectExpression{Properties: properties}
-	} else {
-		panic("yoo yoo")
-	}
-}
-
-func (p *Parser) parseSecondaryExpression(lhs ast.Node) ast.Expression {
-	if p.match(tkn.TokenKindPlus) {
-		p.consume(tkn.TokenKindPlus)
-		return &ast.BinaryExpression{Left: lhs.(ast.Expression), Right: p.parseExpression(), Operator: "+"}
-	} else if p.match(tkn.TokenKindPlusPlus) {
-		p.consume(tkn.TokenKindPlusPlus)
-		return &ast.UpdateExpression{Argument: lhs.(ast.Expression), Operator: "++"}
-	} else if p.match(tkn.TokenKindMinusMinus) {
-		p.consume(tkn.TokenKindMinusMinus)
-		return &ast.UpdateExpression{Argument: lhs.(ast.Expression), Operator: "--"}
-	} else if p.match(tkn.TokenKindGreaterThan) {
-		p.consume(tkn.TokenKindGreaterThan)
-		return &ast.BinaryExpression{Left: lhs.(ast.Expression), Right: p.parseExpression(), Operator: ">"}
-	} else if p.match(tkn.TokenKindLessThan) {
-		p.consume(tkn.TokenKindLessThan)
-		return &ast.BinaryExpression{Left: lhs.(ast.Expression), Right: p.parseExpression(), Operator: "<"}
-	} else if p.match(tkn.TokenKindEqual) {
-		p.consume(tkn.TokenKindEqual)
-		return &ast.AssignmentExpression{Left: lhs.(ast.Expression), Right: p.parseExpression(), Operator: "="}
-	} else if p.match(tkn.TokenKindLeftParen) {
-		return p.parseCallExpression(lhs.(*ast.Identifier))
+		return key, true
+	case p.match(tkn.TokenKindStringLiteral):
+		p.consume(tkn.TokenKindStringLiteral)
+		p.checkLegacyOctal(token)
+		return &ast.StringLiteral{Start: token.Start, End: token.End, Value: token.Value}, false
+	case p.match(tkn.TokenKindNumericLiteral):
+		p.consume(tkn.TokenKindNumericLiteral)
+		p.checkLegacyOctal(token)
+		return &ast.NumericLiteral{Start: token.Start, End: token.End, Value: tkn.NumericValue(token.Value)}, false
+	case p.match(tkn.TokenKindBigIntLiteral):
+		p.consume(tkn.TokenKindBigIntLiteral)
+		return &ast.BigIntLiteral{Start: token.Start, End: token.End, Value: tkn.BigIntValue(token.Value)}, false
+	case p.matchesIdentifierName():
+		p.consume(token.Kind)
+		return &ast.Identifier{Start: token.Start, End: token.End, Name: token.Value}, false
+	default:
+		panic("unexpected property name kind: " + token.Kind.String())
+	}
+}
+
+func (p *Parser) matchesPropertyName(k tkn.TokenKind) bool {
+	return k == tkn.TokenKindIdentifier || k.IsKeyword() ||
+		k == tkn.TokenKindStringLiteral ||
+		k == tkn.TokenKindNumericLiteral ||
+		k == tkn.TokenKindBigIntLiteral ||
+		k == tkn.TokenKindLeftSquareBracket
+}
+
+// https://tc39.es/ecma262/#prod-IdentifierName
+func (p *Parser) matchesIdentifierName() bool {
+	return p.match(tkn.TokenKindIdentifier) || p.kind().IsKeyword()
+}
+
+func (p *Parser) parseSecondaryExpression(lhs ast.Expression) ast.Expression {
+	if p.match(tkn.TokenKindLeftParen) {
+		return p.parseCallExpression(lhs)
+	} else if p.matchesTemplate() {
+		return &ast.TaggedTemplateExpression{Tag: lhs, Quasi: p.parseTemplateLiteral(true)}
 	} else if p.match(tkn.TokenKindLeftSquareBracket) {
+		restore := p.allowIn()
 		p.consume(tkn.TokenKindLeftSquareBracket)
 		property := p.parseExpression()
 		p.consume(tkn.TokenKindRightSquareBracket)
-		return &ast.MemberExpression{Object: lhs.(*ast.Identifier), Property: property}
+		restore()
+		return &ast.MemberExpression{Object: lhs, Property: property, Computed: true}
 	} else if p.match(tkn.TokenKindPeriod) {
 		p.consume(tkn.TokenKindPeriod)
-		property := p.consume(tkn.TokenKindIdentifier).Value
-		return &ast.MemberExpression{Object: lhs.(*ast.Identifier), Property: &ast.Identifier{Name: property}}
+		if p.match(tkn.TokenKindPrivateIdentifier) {
+			return &ast.MemberExpression{Object: lhs, Property: p.parsePrivateIdentifier()}
+		}
+		if !p.matchesIdentifierName() {
+			panic("expected property name but got kind: " + p.kind().String())
+		}
+		property := p.consume(p.kind()).Value
+		return &ast.MemberExpression{Object: lhs, Property: &ast.Identifier{Name: property}}
 	} else {
 		panic("yoo yoo 2")
 	}
 }
 
+// The pattern is parsed as soon as the literal is, so a malformed pattern is
+// an early error.
+// https://tc39.es/ecma262/#sec-regexp-literals-static-semantics-early-errors
+func (p *Parser) parseRegExpLiteral() *ast.RegExpLiteral {
+	token := p.consume(tkn.TokenKindRegularExpressionLiteral)
+	slash := strings.LastIndexByte(token.Value, '/')
+	pattern, flags := token.Value[1:slash], token.Value[slash+1:]
+
+	if _, err := regex.Compile(pattern, flags); err != nil {
+		panic(err.Error())
+	}
+	return &ast.RegExpLiteral{Start: token.Start, End: token.End, Pattern: pattern, Flags: flags}
+}
+
+// https://tc39.es/ecma262/#prod-TemplateLiteral
+func (p *Parser) parseTemplateLiteral(tagged bool) *ast.TemplateLiteral {
+	defer p.allowIn()()
+
+	start := p.current.Start
+
+	var quasis []ast.TemplateElement
+	var expressions []ast.Expression
+	for {
+		token := p.consume(p.kind())
+		element := ast.TemplateElement{
+			Start: token.Start,
+			End:   token.End,
+			Raw:   token.Value,
+			Tail:  token.Kind == tkn.TokenKindNoSubstitutionTemplate || token.Kind == tkn.TokenKindTemplateTail,
+		}
+
+		element.Cooked, element.HasCooked = tkn.CookTemplate(token.Value)
+		if !element.HasCooked && !tagged {
+			panic("invalid escape sequence in template literal")
+		}
+
+		quasis = append(quasis, element)
+		if element.Tail {
+			return &ast.TemplateLiteral{Start: start, End: token.End, Quasis: quasis, Expressions: expressions}
+		}
+
+		expressions = append(expressions, p.parseExpression())
+		if p.match(tkn.TokenKindRightBrace) {
+			p.rescan(tkn.GoalTemplateTail)
+		}
+		if !p.match(tkn.TokenKindTemplateMiddle) && !p.match(tkn.TokenKindTemplateTail) {
+			panic("expected template continuation but got kind: " + p.kind().String())
+		}
+	}
+}
+
 func (p *Parser) matchesStatement() bool {
 	k := p.kind()
 	return p.matchesExpression() ||
 		k == tkn.TokenKindFunction ||
+		k == tkn.TokenKindClass ||
 		k == tkn.TokenKindReturn ||
 		k == tkn.TokenKindVar ||
 		k == tkn.TokenKindLeftBrace ||
@@ -289,22 +1448,81 @@ func (p *Parser) matchesStatement() bool {
 
 func (p *Parser) matchesExpression() bool {
 	k := p.kind()
-	return k == tkn.TokenKindIntLiteral ||
+	if _, ok := unaryOperators[k]; ok {
+		return true
+	}
+
+	return k == tkn.TokenKindNumericLiteral ||
+		k == tkn.TokenKindBigIntLiteral ||
+		k == tkn.TokenKindTrue ||
+		k == tkn.TokenKindFalse ||
+		k == tkn.TokenKindNull ||
+		k == tkn.TokenKindStringLiteral ||
+		k == tkn.TokenKindRegularExpressionLiteral ||
+		k == tkn.TokenKindSlash ||
+		k == tkn.TokenKindSlashEqual ||
+		k == tkn.TokenKindNoSubstitutionTemplate ||
+		k == tkn.TokenKindTemplateHead ||
+		k == tkn.TokenKindPlusPlus ||
+		k == tkn.TokenKindMinusMinus ||
 		k == tkn.TokenKindIdentifier ||
+		k == tkn.TokenKindNew ||
+		k == tkn.TokenKindThis ||
+		k == tkn.TokenKindSuper ||
+		k == tkn.TokenKindClass ||
+		k == tkn.TokenKindPrivateIdentifier ||
 		k == tkn.TokenKindLeftParen ||
 		k == tkn.TokenKindLeftSquareBracket ||
 		k == tkn.TokenKindLeftBrace
 }
 
+func (p *Parser) matchesTemplate() bool {
+	return p.match(tkn.TokenKindNoSubstitutionTemplate) || p.match(tkn.TokenKindTemplateHead)
+}
+
 func (p *Parser) matchesSecondaryExpression() bool {
 	k := p.kind()
-	return k == tkn.TokenKindPlus ||
-		k == tkn.TokenKindPlusPlus ||
-		k == tkn.TokenKindMinusMinus ||
-		k == tkn.TokenKindGreaterThan ||
+	return p.matchesTemplate() ||
 		k == tkn.TokenKindLeftParen ||
-		k == tkn.TokenKindLessThan ||
-		k == tkn.TokenKindEqual ||
 		k == tkn.TokenKindLeftSquareBracket ||
-		k == tkn.TokenKindPeriod
+		k == tkn.TokenKindPeriod ||
+		k == tkn.TokenKindQuestionPeriod
+}
+
+// tokenList is a TokenSource over tokens scanned in advance.
+type tokenList struct {
+	tokens []tkn.Token
+	offset int
+}
+
+// Next returns the tokens in turn, repeating the last one, which is the EOF
+// token.
+func (l *tokenList) Next() (tkn.Token, error) {
+	if len(l.tokens) == 0 {
+		return tkn.Token{Kind: tkn.TokenKindEOF}, nil
+	}
+
+	token := l.tokens[l.offset]
+	if l.offset < len(l.tokens)-1 {
+		l.offset++
+	}
+	return token, nil
+}
+
+// Rescan cannot scan the token again without its source text, so it returns
+// the token as it is, continuing with the tokens after it. A slash the
+// tokenizer scanned as a division cannot become a regular expression literal.
+func (l *tokenList) Rescan(token tkn.Token, goal tkn.Goal) (tkn.Token, error) {
+	if (goal == tkn.GoalRegExp || goal == tkn.GoalRegExpOrTemplateTail) &&
+		(token.Kind == tkn.TokenKindSlash || token.Kind == tkn.TokenKindSlashEqual) {
+		return token, &tkn.SyntaxError{Offset: token.Start, Location: token.Location, Message: "token cannot be rescanned"}
+	}
+
+	for idx := l.offset; idx >= 0 && idx < len(l.tokens); idx-- {
+		if l.tokens[idx].Start == token.Start && l.tokens[idx].Kind == token.Kind {
+			l.offset = idx
+			return l.Next()
+		}
+	}
+	return token, nil
 }
@@ -0,0 +1,178 @@
+package parse
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"gojs/ast"
+	"gojs/tkn"
+)
+
+const sourceTextProgram = `function foo(a,   b) {
+  // sum
+  return a + /* b */ b
+}
+class C { static m(x) { return x } }
+var o = { get g() { return 1 } }
+`
+
+// sourceTexts returns the source text of the functions, classes and methods
+// of a program.
+func sourceTexts(program ast.Program) []string {
+	var texts []string
+	for _, n := range program.Body {
+		switch n := n.(type) {
+		case *ast.FunctionDeclaration:
+			texts = append(texts, n.SourceText)
+		case *ast.ClassDeclaration:
+			texts = append(texts, n.SourceText)
+			for _, element := range n.Body.Body {
+				texts = append(texts, element.(*ast.MethodDefinition).Value.SourceText)
+			}
+		case *ast.VariableDeclaration:
+			o := n.Declarations[0].Init.(*ast.ObjectExpression)
+			texts = append(texts, o.Properties[0].(*ast.Property).Value.(*ast.FunctionExpression).SourceText)
+		}
+	}
+	return texts
+}
+
+func TestSourceText(t *testing.T) {
+	exact := []string{
+		"function foo(a,   b) {\n  // sum\n  return a + /* b */ b\n}",
+		"class C { static m(x) { return x } }",
+		"m(x) { return x }",
+		"get g() { return 1 }",
+	}
+
+	tests := []struct {
+		name   string
+		parser func() *Parser
+		want   []string
+	}{
+		{
+			name: "source",
+			parser: func() *Parser {
+				t := tkn.Tokenizer{}
+				return NewParser(t.Tokenize(sourceTextProgram), WithSource(sourceTextProgram))
+			},
+			want: exact,
+		},
+		{
+			name: "stream",
+			parser: func() *Parser {
+				r := iotest.OneByteReader(strings.NewReader(sourceTextProgram))
+				return NewStreamParser(tkn.NewTokenizer(r))
+			},
+			want: exact,
+		},
+		{
+			name: "tokens with trivia",
+			parser: func() *Parser {
+				t := tkn.NewTokenizer(nil, tkn.WithTrivia())
+				return NewParser(t.Tokenize(sourceTextProgram))
+			},
+			want: exact,
+		},
+		{
+			name: "tokens",
+			parser: func() *Parser {
+				t := tkn.Tokenizer{}
+				return NewParser(t.Tokenize(sourceTextProgram))
+			},
+			want: []string{
+				"function foo(a, b) {\nreturn a + b\n}",
+				"class C { static m(x) { return x } }",
+				"m(x) { return x }",
+				"get g() { return 1 }",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sourceTexts(tt.parser().Parse())
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+			for idx := range tt.want {
+				if got[idx] != tt.want[idx] {
+					t.Errorf("got %q, want %q", got[idx], tt.want[idx])
+				}
+			}
+		})
+	}
+}
+
+// The text of a function is recorded across tokens the parser scans again
+// with another goal than the tokenizer guessed.
+func TestSourceTextRescan(t *testing.T) {
+	src := "function r(s) { var o = {} / 2; {} /x/g.test(s) /* re */ }\n"
+	r := iotest.OneByteReader(strings.NewReader(src))
+	program := NewStreamParser(tkn.NewTokenizer(r, tkn.WithTrivia())).Parse()
+
+	got := program.Body[0].(*ast.FunctionDeclaration).SourceText
+	if want := strings.TrimSpace(src); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+// retainTracker records the longest span of source text the parser holds on
+// to while it reads tokens.
+type retainTracker struct {
+	*tkn.Tokenizer
+	retained []int
+	longest  int
+}
+
+func (r *retainTracker) Retain(offset int) {
+	r.retained = append(r.retained, offset)
+	r.Tokenizer.Retain(offset)
+}
+
+func (r *retainTracker) Release() {
+	r.retained = r.retained[:len(r.retained)-1]
+	r.Tokenizer.Release()
+}
+
+func (r *retainTracker) Next() (tkn.Token, error) {
+	token, err := r.Tokenizer.Next()
+	if len(r.retained) > 0 && token.End-r.retained[0] > r.longest {
+		r.longest = token.End - r.retained[0]
+	}
+	return token, err
+}
+
+// Plain properties have no source text, so the parser does not hold on to the
+// text of their values.
+func TestSourceTextRetainsMethods(t *testing.T) {
+	value := "[" + strings.Repeat("1, ", 1000) + "]"
+	src := "var o = { a: " + value + ", b, [c]: " + value + ", m() {}, get g() { return 1 } }"
+	r := &retainTracker{Tokenizer: tkn.NewTokenizerBytes([]byte(src))}
+	NewStreamParser(r).Parse()
+	if r.longest >= len(value) {
+		t.Errorf("held on to %d bytes of source text", r.longest)
+	}
+}
+
+func TestComments(t *testing.T) {
+	src := "#!/bin/js\n/** Adds. */\nfunction add(a, b) { return a + b } // end\nvar o = {} /* over */ / 2\n"
+	r := iotest.OneByteReader(strings.NewReader(src))
+	program := NewStreamParser(tkn.NewTokenizer(r, tkn.WithTrivia())).Parse()
+
+	want := []ast.Comment{
+		{Start: 0, End: 9, Text: "/bin/js"},
+		{Start: 10, End: 22, Text: "* Adds. ", Multiline: true},
+		{Start: 59, End: 65, Text: " end"},
+		{Start: 77, End: 87, Text: " over ", Multiline: true},
+	}
+	if len(program.Comments) != len(want) {
+		t.Fatalf("got %+v, want %+v", program.Comments, want)
+	}
+	for idx := range want {
+		if program.Comments[idx] != want[idx] {
+			t.Errorf("got %+v, want %+v", program.Comments[idx], want[idx])
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package parse
+
+import (
+	"gojs/ast"
+	"gojs/tkn"
+)
+
+// https://tc39.es/ecma262/#prod-BindingIdentifier
+// https://tc39.es/ecma262/#prod-BindingPattern
+func (p *Parser) parseBindingTarget() ast.Pattern {
+	switch {
+	case p.match(tkn.TokenKindLeftSquareBracket):
+		return p.parseArrayBindingPattern()
+	case p.match(tkn.TokenKindLeftBrace):
+		return p.parseObjectBindingPattern()
+	default:
+		token := p.consume(tkn.TokenKindIdentifier)
+		p.checkBindingIdentifier(token.Value)
+		return &ast.Identifier{Start: token.Start, End: token.End, Name: token.Value}
+	}
+}
+
+// https://tc39.es/ecma262/#prod-BindingElement
+func (p *Parser) parseBindingElement() ast.Pattern {
+	start := p.current.Start
+	target := p.parseBindingTarget()
+	if !p.match(tkn.TokenKindEqual) {
+		return target
+	}
+
+	p.consume(tkn.TokenKindEqual)
+	right := p.parseAssignmentExpression()
+	return &ast.AssignmentPattern{Start: start, End: p.previous.End, Left: target, Right: right}
+}
+
+// https://tc39.es/ecma262/#prod-ArrayBindingPattern
+func (p *Parser) parseArrayBindingPattern() *ast.ArrayPattern {
+	start := p.consume(tkn.TokenKindLeftSquareBracket).Start
+
+	var elements []ast.Pattern
+	for !p.match(tkn.TokenKindRightSquareBracket) {
+		if p.match(tkn.TokenKindComma) {
+			p.consume(tkn.TokenKindComma)
+			elements = append(elements, nil)
+			continue
+		}
+
+		// The rest element must be the last one, without a trailing comma.
+		if p.match(tkn.TokenKindSpread) {
+			restStart := p.consume(tkn.TokenKindSpread).Start
+			argument := p.parseBindingTarget()
+			elements = append(elements, &ast.RestElement{Start: restStart, End: p.previous.End, Argument: argument})
+			break
+		}
+
+		elements = append(elements, p.parseBindingElement())
+		if !p.match(tkn.TokenKindRightSquareBracket) {
+			p.consume(tkn.TokenKindComma)
+		}
+	}
+	end := p.consume(tkn.TokenKindRightSquareBracket).End
+
+	return &ast.ArrayPattern{Start: start, End: end, Elements: elements}
+}
+
+// https://tc39.es/ecma262/#prod-ObjectBindingPattern
+func (p *Parser) parseObjectBindingPattern() *ast.ObjectPattern {
+	start := p.consume(tkn.TokenKindLeftBrace).Start
+
+	var properties []ast.Node
+	for !p.match(tkn.TokenKindRightBrace) {
+		propertyStart := p.current.Start
+		if p.match(tkn.TokenKindSpread) {
+			p.consume(tkn.TokenKindSpread)
+			token := p.consume(tkn.TokenKindIdentifier)
+			p.checkBindingIdentifier(token.Value)
+			argument := &ast.Identifier{Start: token.Start, End: token.End, Name: token.Value}
+			properties = append(properties, &ast.RestElement{Start: propertyStart, End: token.End, Argument: argument})
+			break
+		}
+
+		identifier := p.match(tkn.TokenKindIdentifier)
+		key, computed := p.parsePropertyName()
+		property := &ast.Property{Start: propertyStart, Key: key, Kind: "init", Computed: computed}
+
+		switch {
+		case p.match(tkn.TokenKindColon):
+			p.consume(tkn.TokenKindColon)
+			property.Value = p.parseBindingElement()
+		case identifier:
+			name := key.(*ast.Identifier)
+			p.checkBindingIdentifier(name.Name)
+			var value ast.Pattern = &ast.Identifier{Start: name.Start, End: name.End, Name: name.Name}
+			if p.match(tkn.TokenKindEqual) {
+				p.consume(tkn.TokenKindEqual)
+				right := p.parseAssignmentExpression()
+				value = &ast.AssignmentPattern{Start: name.Start, End: p.previous.End, Left: value, Right: right}
+			}
+			property.Shorthand = true
+			property.Value = value
+		default:
+			panic("expected ':' after property name but got kind: " + p.kind().String())
+		}
+
+		property.End = p.previous.End
+		properties = append(properties, property)
+		if !p.match(tkn.TokenKindRightBrace) {
+			p.consume(tkn.TokenKindComma)
+		}
+	}
+	end := p.consume(tkn.TokenKindRightBrace).End
+
+	return &ast.ObjectPattern{Start: start, End: end, Properties: properties}
+}
+
+// toAssignmentPattern reinterprets an object or array literal on the left of
+// an assignment as the assignment pattern it covers.
+// https://tc39.es/ecma262/#sec-destructuring-assignment-static-semantics-early-errors
+func toAssignmentPattern(expr ast.Expression) ast.Pattern {
+	switch n := expr.(type) {
+	case *ast.Identifier:
+		return n
+	case *ast.MemberExpression:
+		return n
+	case *ast.ParenthesizedExpression:
+		if !isSimpleAssignmentTarget(n) {
+			panic("invalid destructuring assignment target")
+		}
+		return n
+	case *ast.ObjectExpression:
+		pattern := &ast.ObjectPattern{Start: n.Start, End: n.End}
+		for idx, property := range n.Properties {
+			switch property := property.(type) {
+			case *ast.SpreadElement:
+				if idx != len(n.Properties)-1 || !isSimpleAssignmentTarget(property.Argument) {
+					panic("invalid rest element in object pattern")
+				}
+				pattern.Properties = append(pattern.Properties, &ast.RestElement{
+					Start:    property.Start,
+					End:      property.End,
+					Argument: toAssignmentPattern(property.Argument),
+				})
+			case *ast.Property:
+				if property.Kind != "init" || property.Method {
+					panic("invalid destructuring assignment target")
+				}
+
+				target := *property
+				target.Value = toAssignmentElement(property.Value)
+				pattern.Properties = append(pattern.Properties, &target)
+			}
+		}
+		return pattern
+	case *ast.ArrayExpression:
+		pattern := &ast.ArrayPattern{Start: n.Start, End: n.End}
+		for idx, element := range n.Elements {
+			switch element := element.(type) {
+			case nil:
+				pattern.Elements = append(pattern.Elements, nil)
+			case *ast.SpreadElement:
+				if idx != len(n.Elements)-1 {
+					panic("rest element must be last element")
+				}
+				pattern.Elements = append(pattern.Elements, &ast.RestElement{
+					Start:    element.Start,
+					End:      element.End,
+					Argument: toAssignmentPattern(element.Argument),
+				})
+			default:
+				pattern.Elements = append(pattern.Elements, toAssignmentElement(element))
+			}
+		}
+		return pattern
+	default:
+		panic("invalid destructuring assignment target")
+	}
+}
+
+// toAssignmentElement reinterprets an element of an assignment pattern, which
+// may give a default value for its target.
+// https://tc39.es/ecma262/#prod-AssignmentElement
+func toAssignmentElement(expr ast.Expression) ast.Pattern {
+	switch n := expr.(type) {
+	case *ast.AssignmentPattern:
+		return n
+	case *ast.AssignmentExpression:
+		if n.Operator == "=" {
+			return &ast.AssignmentPattern{Start: n.Start, End: n.End, Left: n.Left.(ast.Pattern), Right: n.Right}
+		}
+	}
+	return toAssignmentPattern(expr)
+}
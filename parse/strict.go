@@ -0,0 +1,130 @@
+package parse
+
+import (
+	"gojs/ast"
+	"gojs/tkn"
+)
+
+// strictReservedWords are identifiers in sloppy mode but reserved words in
+// strict mode code.
+// https://tc39.es/ecma262/#sec-keywords-and-reserved-words
+var strictReservedWords = map[string]bool{
+	"implements": true,
+	"interface":  true,
+	"let":        true,
+	"package":    true,
+	"private":    true,
+	"protected":  true,
+	"public":     true,
+	"static":     true,
+	"yield":      true,
+}
+
+// https://tc39.es/ecma262/#sec-identifiers-static-semantics-early-errors
+func (p *Parser) checkIdentifierReference(name string) {
+	// Reserved words only reach here spelled with escape sequences.
+	if tkn.IsReservedWord(name) {
+		panic("keyword must not contain escaped characters: " + name)
+	}
+	if p.strict && strictReservedWords[name] {
+		panic("unexpected strict mode reserved word: " + name)
+	}
+	if p.generator && name == "yield" {
+		panic("yield is not a valid identifier in a generator")
+	}
+
+	// https://tc39.es/ecma262/#sec-class-definitions-static-semantics-early-errors
+	if p.fieldInitializer && name == "arguments" {
+		panic("'arguments' is not allowed in class field initializer or static initialization block")
+	}
+}
+
+// https://tc39.es/ecma262/#sec-identifiers-static-semantics-early-errors
+func (p *Parser) checkBindingIdentifier(name string) {
+	p.checkIdentifierReference(name)
+	if p.strict && (name == "eval" || name == "arguments") {
+		panic("unexpected eval or arguments in strict mode")
+	}
+}
+
+// checkAssignmentTarget rejects assigning to eval or arguments in strict mode
+// code, including through a destructuring pattern.
+// https://tc39.es/ecma262/#sec-static-semantics-assignmenttargettype
+func (p *Parser) checkAssignmentTarget(target ast.Expression) {
+	if !p.strict {
+		return
+	}
+
+	names := []string{}
+	switch n := ast.Unparenthesized(target).(type) {
+	case *ast.Identifier:
+		names = append(names, n.Name)
+	case ast.Pattern:
+		names = ast.BoundNames(n)
+	}
+
+	for _, name := range names {
+		if name == "eval" || name == "arguments" {
+			panic("unexpected eval or arguments in strict mode")
+		}
+	}
+}
+
+// https://tc39.es/ecma262/#sec-additional-syntax-numeric-literals
+// https://tc39.es/ecma262/#sec-additional-syntax-string-literals
+func (p *Parser) checkLegacyOctal(token tkn.Token) {
+	if !p.strict || !token.LegacyOctal {
+		return
+	}
+
+	if token.Kind == tkn.TokenKindStringLiteral {
+		panic("octal escape sequences are not allowed in strict mode")
+	}
+	panic("octal literals are not allowed in strict mode")
+}
+
+// checkParameters applies the early errors of a parameter list once the body
+// of its function is parsed, since a "use strict" directive in the body makes
+// the parameters strict mode code as well. The lexical names are those the
+// body declares, which the parameters may not declare again.
+// https://tc39.es/ecma262/#sec-function-definitions-static-semantics-early-errors
+func (p *Parser) checkParameters(parameters []ast.Pattern, useStrict bool, lexical map[string]bool) {
+	simple := ast.IsSimpleParameterList(parameters)
+	if useStrict && !simple {
+		panic("illegal 'use strict' directive in function with non-simple parameter list")
+	}
+
+	seen := make(map[string]bool)
+	for _, parameter := range parameters {
+		for _, name := range ast.BoundNames(parameter) {
+			p.checkBindingIdentifier(name)
+			if seen[name] && (p.strict || !simple) {
+				panic("duplicate parameter name not allowed in this context")
+			}
+			if lexical[name] {
+				panic(redeclaration(name))
+			}
+			seen[name] = true
+		}
+	}
+}
+
+// isDirective reports whether the statement of a directive prologue is a
+// directive, which is a string literal on its own.
+// https://tc39.es/ecma262/#directive-prologue
+func isDirective(statement ast.Statement) bool {
+	expression, ok := statement.(*ast.ExpressionStatement)
+	if !ok {
+		return false
+	}
+
+	_, ok = expression.Expression.(*ast.StringLiteral)
+	return ok
+}
+
+// isUseStrictDirective reports whether the token of a directive spells out
+// "use strict" exactly, without escape sequences or line continuations.
+// https://tc39.es/ecma262/#use-strict-directive
+func isUseStrictDirective(token tkn.Token) bool {
+	return token.Raw == `"use strict"` || token.Raw == `'use strict'`
+}
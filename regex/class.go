@@ -0,0 +1,540 @@
+package regex
+
+import "unicode"
+
+type runeRange struct {
+	lo, hi rune
+}
+
+// classSet is the set of characters matched by a character class. With the v
+// flag it may also contain strings, which are kept separately when they are
+// not exactly one character long.
+// https://tc39.es/ecma262/#sec-charset
+type classSet struct {
+	ranges     []runeRange
+	predicates []func(ch rune) bool
+	strings    [][]rune
+}
+
+func classSetOf(ch rune) *classSet {
+	return &classSet{ranges: []runeRange{{ch, ch}}}
+}
+
+func (s *classSet) contains(ch rune) bool {
+	for _, r := range s.ranges {
+		if ch >= r.lo && ch <= r.hi {
+			return true
+		}
+	}
+
+	for _, predicate := range s.predicates {
+		if predicate(ch) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *classSet) addRange(lo, hi rune) {
+	s.ranges = append(s.ranges, runeRange{lo, hi})
+}
+
+// addAtom adds a class atom, which is either a single character or a class
+// escape.
+func (s *classSet) addAtom(ch rune, set *classSet) {
+	if set == nil {
+		s.addRange(ch, ch)
+		return
+	}
+
+	s.ranges = append(s.ranges, set.ranges...)
+	s.predicates = append(s.predicates, set.predicates...)
+	s.strings = append(s.strings, set.strings...)
+}
+
+func (s *classSet) addString(str []rune) {
+	if len(str) == 1 {
+		s.addRange(str[0], str[0])
+		return
+	}
+
+	if !s.hasString(str) {
+		s.strings = append(s.strings, str)
+	}
+}
+
+func (s *classSet) hasString(str []rune) bool {
+	if len(str) == 1 {
+		return s.contains(str[0])
+	}
+
+	for _, existing := range s.strings {
+		if string(existing) == string(str) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *classSet) union(other *classSet) *classSet {
+	u := &classSet{}
+	u.addAtom(0, s)
+	u.addAtom(0, other)
+	return u
+}
+
+// https://tc39.es/ecma262/#sec-compiletocharset
+func (s *classSet) intersect(other *classSet) *classSet {
+	i := &classSet{predicates: []func(rune) bool{func(ch rune) bool {
+		return s.contains(ch) && other.contains(ch)
+	}}}
+
+	for _, str := range s.strings {
+		if other.hasString(str) {
+			i.strings = append(i.strings, str)
+		}
+	}
+	return i
+}
+
+func (s *classSet) subtract(other *classSet) *classSet {
+	d := &classSet{predicates: []func(rune) bool{func(ch rune) bool {
+		return s.contains(ch) && !other.contains(ch)
+	}}}
+
+	for _, str := range s.strings {
+		if !other.hasString(str) {
+			d.strings = append(d.strings, str)
+		}
+	}
+	return d
+}
+
+// https://tc39.es/ecma262/#sec-charactercomplement
+func (s *classSet) complement() *classSet {
+	return &classSet{predicates: []func(rune) bool{func(ch rune) bool {
+		return !s.contains(ch)
+	}}}
+}
+
+// builtinClass returns the set of a CharacterClassEscape. When both the u
+// and i flags are present, \w also contains the characters that case fold
+// into ASCII word characters.
+// https://tc39.es/ecma262/#sec-compiletocharset
+func builtinClass(escape rune, unicodeIgnoreCase bool) *classSet {
+	var set *classSet
+	switch unicode.ToLower(escape) {
+	case 'd':
+		set = &classSet{ranges: []runeRange{{'0', '9'}}}
+	case 's':
+		set = &classSet{predicates: []func(rune) bool{isWhiteSpaceOrLineTerminator}}
+	case 'w':
+		set = &classSet{ranges: []runeRange{{'a', 'z'}, {'A', 'Z'}, {'0', '9'}, {'_', '_'}}}
+		if unicodeIgnoreCase {
+			set.addRange(0x017F, 0x017F)
+			set.addRange(0x212A, 0x212A)
+		}
+	}
+
+	if unicode.IsUpper(escape) {
+		return set.complement()
+	}
+	return set
+}
+
+// https://tc39.es/ecma262/#sec-white-space
+// https://tc39.es/ecma262/#sec-line-terminators
+func isWhiteSpaceOrLineTerminator(ch rune) bool {
+	switch ch {
+	case '\t', '\v', '\f', '\uFEFF':
+		return true
+	}
+	return isLineTerminator(ch) || unicode.Is(unicode.Zs, ch)
+}
+
+func isLineTerminator(ch rune) bool {
+	return ch == '\n' || ch == '\r' || ch == '\u2028' || ch == '\u2029'
+}
+
+// https://tc39.es/ecma262/#table-unicode-general-category-values
+var generalCategories = map[string]string{
+	"Letter":                "L",
+	"Cased_Letter":          "LC",
+	"Uppercase_Letter":      "Lu",
+	"Lowercase_Letter":      "Ll",
+	"Titlecase_Letter":      "Lt",
+	"Modifier_Letter":       "Lm",
+	"Other_Letter":          "Lo",
+	"Mark":                  "M",
+	"Combining_Mark":        "M",
+	"Nonspacing_Mark":       "Mn",
+	"Spacing_Mark":          "Mc",
+	"Enclosing_Mark":        "Me",
+	"Number":                "N",
+	"Decimal_Number":        "Nd",
+	"digit":                 "Nd",
+	"Letter_Number":         "Nl",
+	"Other_Number":          "No",
+	"Punctuation":           "P",
+	"punct":                 "P",
+	"Connector_Punctuation": "Pc",
+	"Dash_Punctuation":      "Pd",
+	"Open_Punctuation":      "Ps",
+	"Close_Punctuation":     "Pe",
+	"Initial_Punctuation":   "Pi",
+	"Final_Punctuation":     "Pf",
+	"Other_Punctuation":     "Po",
+	"Symbol":                "S",
+	"Math_Symbol":           "Sm",
+	"Currency_Symbol":       "Sc",
+	"Modifier_Symbol":       "Sk",
+	"Other_Symbol":          "So",
+	"Separator":             "Z",
+	"Space_Separator":       "Zs",
+	"Line_Separator":        "Zl",
+	"Paragraph_Separator":   "Zp",
+	"Other":                 "C",
+	"Control":               "Cc",
+	"cntrl":                 "Cc",
+	"Format":                "Cf",
+	"Surrogate":             "Cs",
+	"Private_Use":           "Co",
+	"Unassigned":            "Cn",
+}
+
+// unicodeProperty returns the set of a property escape, either name=value or
+// a lone general category or binary property name. Properties of strings are
+// only valid with the v flag.
+// https://tc39.es/ecma262/#sec-runtime-semantics-unicodematchproperty-p
+func unicodeProperty(name, value string, hasValue, unicodeSets bool) (*classSet, bool) {
+	if hasValue {
+		switch name {
+		case "General_Category", "gc":
+			return generalCategory(value)
+		case "Script", "sc":
+			return script(value, false)
+		case "Script_Extensions", "scx":
+			return script(value, true)
+		}
+		return nil, false
+	}
+
+	if set, ok := generalCategory(name); ok {
+		return set, true
+	}
+	if set, ok := binaryProperty(name); ok {
+		return set, true
+	}
+	if unicodeSets {
+		return stringProperty(name)
+	}
+	return nil, false
+}
+
+func generalCategory(name string) (*classSet, bool) {
+	if long, ok := generalCategories[name]; ok {
+		name = long
+	}
+
+	switch name {
+	case "LC":
+		return tableSet(unicode.Lu, unicode.Ll, unicode.Lt), true
+	case "Cn":
+		return (&classSet{predicates: []func(rune) bool{isAssigned}}).complement(), true
+	case "C":
+		return (&classSet{predicates: []func(rune) bool{isAssigned}}).complement().union(tableSet(unicode.C)), true
+	}
+
+	if table, ok := unicode.Categories[name]; ok {
+		return tableSet(table), true
+	}
+	return nil, false
+}
+
+// script returns the set of a Script value, or of a Script_Extensions value,
+// which also contains the characters used with the script and not the
+// characters listing other scripts.
+// https://www.unicode.org/reports/tr24/#Script_Extensions
+func script(value string, extensions bool) (*classSet, bool) {
+	name, ok := scriptNames[value]
+	if !ok {
+		return nil, false
+	}
+
+	var set *classSet
+	switch table := unicode.Scripts[name]; {
+	case name == "Unknown":
+		tables := make([]*unicode.RangeTable, 0, len(unicode.Scripts))
+		for _, t := range unicode.Scripts {
+			tables = append(tables, t)
+		}
+		set = tableSet(tables...).complement()
+	case table != nil:
+		set = tableSet(table)
+	default:
+		set = &classSet{}
+	}
+
+	if !extensions {
+		return set, true
+	}
+
+	table := scriptExtensions[name]
+	return &classSet{predicates: []func(rune) bool{func(ch rune) bool {
+		if unicode.Is(scriptExtended, ch) {
+			return table != nil && unicode.Is(table, ch)
+		}
+		return set.contains(ch)
+	}}}, true
+}
+
+// https://tc39.es/ecma262/#table-binary-unicode-properties
+var binaryProperties = map[string]string{
+	"ASCII":                        "ASCII",
+	"ASCII_Hex_Digit":              "ASCII_Hex_Digit",
+	"AHex":                         "ASCII_Hex_Digit",
+	"Alphabetic":                   "Alphabetic",
+	"Alpha":                        "Alphabetic",
+	"Any":                          "Any",
+	"Assigned":                     "Assigned",
+	"Bidi_Control":                 "Bidi_Control",
+	"Bidi_C":                       "Bidi_Control",
+	"Bidi_Mirrored":                "Bidi_Mirrored",
+	"Bidi_M":                       "Bidi_Mirrored",
+	"Case_Ignorable":               "Case_Ignorable",
+	"CI":                           "Case_Ignorable",
+	"Cased":                        "Cased",
+	"Changes_When_Casefolded":      "Changes_When_Casefolded",
+	"CWCF":                         "Changes_When_Casefolded",
+	"Changes_When_Casemapped":      "Changes_When_Casemapped",
+	"CWCM":                         "Changes_When_Casemapped",
+	"Changes_When_Lowercased":      "Changes_When_Lowercased",
+	"CWL":                          "Changes_When_Lowercased",
+	"Changes_When_NFKC_Casefolded": "Changes_When_NFKC_Casefolded",
+	"CWKCF":                        "Changes_When_NFKC_Casefolded",
+	"Changes_When_Titlecased":      "Changes_When_Titlecased",
+	"CWT":                          "Changes_When_Titlecased",
+	"Changes_When_Uppercased":      "Changes_When_Uppercased",
+	"CWU":                          "Changes_When_Uppercased",
+	"Dash":                         "Dash",
+	"Default_Ignorable_Code_Point": "Default_Ignorable_Code_Point",
+	"DI":                           "Default_Ignorable_Code_Point",
+	"Deprecated":                   "Deprecated",
+	"Dep":                          "Deprecated",
+	"Diacritic":                    "Diacritic",
+	"Dia":                          "Diacritic",
+	"Emoji":                        "Emoji",
+	"Emoji_Component":              "Emoji_Component",
+	"EComp":                        "Emoji_Component",
+	"Emoji_Modifier":               "Emoji_Modifier",
+	"EMod":                         "Emoji_Modifier",
+	"Emoji_Modifier_Base":          "Emoji_Modifier_Base",
+	"EBase":                        "Emoji_Modifier_Base",
+	"Emoji_Presentation":           "Emoji_Presentation",
+	"EPres":                        "Emoji_Presentation",
+	"Extended_Pictographic":        "Extended_Pictographic",
+	"ExtPict":                      "Extended_Pictographic",
+	"Extender":                     "Extender",
+	"Ext":                          "Extender",
+	"Grapheme_Base":                "Grapheme_Base",
+	"Gr_Base":                      "Grapheme_Base",
+	"Grapheme_Extend":              "Grapheme_Extend",
+	"Gr_Ext":                       "Grapheme_Extend",
+	"Hex_Digit":                    "Hex_Digit",
+	"Hex":                          "Hex_Digit",
+	"IDS_Binary_Operator":          "IDS_Binary_Operator",
+	"IDSB":                         "IDS_Binary_Operator",
+	"IDS_Trinary_Operator":         "IDS_Trinary_Operator",
+	"IDST":                         "IDS_Trinary_Operator",
+	"ID_Continue":                  "ID_Continue",
+	"IDC":                          "ID_Continue",
+	"ID_Start":                     "ID_Start",
+	"IDS":                          "ID_Start",
+	"Ideographic":                  "Ideographic",
+	"Ideo":                         "Ideographic",
+	"Join_Control":                 "Join_Control",
+	"Join_C":                       "Join_Control",
+	"Logical_Order_Exception":      "Logical_Order_Exception",
+	"LOE":                          "Logical_Order_Exception",
+	"Lowercase":                    "Lowercase",
+	"Lower":                        "Lowercase",
+	"Math":                         "Math",
+	"Noncharacter_Code_Point":      "Noncharacter_Code_Point",
+	"NChar":                        "Noncharacter_Code_Point",
+	"Pattern_Syntax":               "Pattern_Syntax",
+	"Pat_Syn":                      "Pattern_Syntax",
+	"Pattern_White_Space":          "Pattern_White_Space",
+	"Pat_WS":                       "Pattern_White_Space",
+	"Quotation_Mark":               "Quotation_Mark",
+	"QMark":                        "Quotation_Mark",
+	"Radical":                      "Radical",
+	"Regional_Indicator":           "Regional_Indicator",
+	"RI":                           "Regional_Indicator",
+	"Sentence_Terminal":            "Sentence_Terminal",
+	"STerm":                        "Sentence_Terminal",
+	"Soft_Dotted":                  "Soft_Dotted",
+	"SD":                           "Soft_Dotted",
+	"Terminal_Punctuation":         "Terminal_Punctuation",
+	"Term":                         "Terminal_Punctuation",
+	"Unified_Ideograph":            "Unified_Ideograph",
+	"UIdeo":                        "Unified_Ideograph",
+	"Uppercase":                    "Uppercase",
+	"Upper":                        "Uppercase",
+	"Variation_Selector":           "Variation_Selector",
+	"VS":                           "Variation_Selector",
+	"White_Space":                  "White_Space",
+	"space":                        "White_Space",
+	"XID_Continue":                 "XID_Continue",
+	"XIDC":                         "XID_Continue",
+	"XID_Start":                    "XID_Start",
+	"XIDS":                         "XID_Start",
+}
+
+// The derived properties below follow DerivedCoreProperties.txt, so they
+// agree with the tables of the unicode package.
+// https://www.unicode.org/reports/tr44/#DerivedCoreProperties.txt
+func binaryProperty(name string) (*classSet, bool) {
+	name, ok := binaryProperties[name]
+	if !ok {
+		return nil, false
+	}
+
+	switch name {
+	case "Any":
+		return &classSet{ranges: []runeRange{{0, unicode.MaxRune}}}, true
+	case "ASCII":
+		return &classSet{ranges: []runeRange{{0, 0x7F}}}, true
+	case "Assigned":
+		return &classSet{predicates: []func(rune) bool{isAssigned}}, true
+	case "Alphabetic":
+		return tableSet(unicode.L, unicode.Nl, unicode.Other_Alphabetic), true
+	case "Lowercase":
+		return tableSet(unicode.Ll, unicode.Other_Lowercase), true
+	case "Uppercase":
+		return tableSet(unicode.Lu, unicode.Other_Uppercase), true
+	case "Cased":
+		return tableSet(unicode.Lu, unicode.Ll, unicode.Lt, unicode.Other_Lowercase, unicode.Other_Uppercase), true
+	case "Case_Ignorable":
+		set := tableSet(unicode.Mn, unicode.Me, unicode.Cf, unicode.Lm, unicode.Sk)
+		set.ranges = caseIgnorablePunctuation
+		return set, true
+	case "Math":
+		return tableSet(unicode.Sm, unicode.Other_Math), true
+	case "Default_Ignorable_Code_Point":
+		return &classSet{predicates: []func(rune) bool{isDefaultIgnorable}}, true
+	case "Grapheme_Extend":
+		return tableSet(unicode.Mn, unicode.Me, unicode.Other_Grapheme_Extend), true
+	case "Grapheme_Base":
+		return &classSet{predicates: []func(rune) bool{isGraphemeBase}}, true
+	case "ID_Start":
+		return &classSet{predicates: []func(rune) bool{isIDStart}}, true
+	case "ID_Continue":
+		return &classSet{predicates: []func(rune) bool{isIDContinue}}, true
+	case "XID_Start":
+		return (&classSet{predicates: []func(rune) bool{isIDStart}}).subtract(&classSet{ranges: xidStartExcluded}), true
+	case "XID_Continue":
+		return (&classSet{predicates: []func(rune) bool{isIDContinue}}).subtract(&classSet{ranges: xidContinueExcluded}), true
+	}
+
+	if table, ok := propertyTables[name]; ok {
+		return tableSet(table), true
+	}
+	return tableSet(unicode.Properties[name]), true
+}
+
+// https://tc39.es/ecma262/#table-binary-unicode-properties-of-strings
+var stringProperties = []string{
+	"Basic_Emoji",
+	"Emoji_Keycap_Sequence",
+	"RGI_Emoji_Modifier_Sequence",
+	"RGI_Emoji_Flag_Sequence",
+	"RGI_Emoji_Tag_Sequence",
+	"RGI_Emoji_ZWJ_Sequence",
+}
+
+// stringProperty returns the set of a property of strings. RGI_Emoji is the
+// union of the others.
+func stringProperty(name string) (*classSet, bool) {
+	names := stringProperties
+	if name != "RGI_Emoji" {
+		if _, ok := emojiSequences[name]; !ok {
+			return nil, false
+		}
+		names = []string{name}
+	}
+
+	chars := make(map[rune]bool)
+	set := &classSet{predicates: []func(rune) bool{func(ch rune) bool {
+		return chars[ch]
+	}}}
+	for _, name := range names {
+		for _, seq := range emojiSequences[name] {
+			if str := []rune(seq); len(str) == 1 {
+				chars[str[0]] = true
+			} else {
+				set.strings = append(set.strings, str)
+			}
+		}
+	}
+	return set, true
+}
+
+func tableSet(tables ...*unicode.RangeTable) *classSet {
+	return &classSet{predicates: []func(rune) bool{func(ch rune) bool {
+		return unicode.In(ch, tables...)
+	}}}
+}
+
+// The unicode.C table also holds the noncharacters, which are unassigned.
+func isAssigned(ch rune) bool {
+	return unicode.In(ch, unicode.L, unicode.M, unicode.N, unicode.P, unicode.S, unicode.Z, unicode.Cc, unicode.Cf, unicode.Cs, unicode.Co)
+}
+
+// The characters of Word_Break MidLetter, MidNumLet and Single_Quote, which
+// are case ignorable.
+var caseIgnorablePunctuation = []runeRange{
+	{0x0027, 0x0027}, {0x002E, 0x002E}, {0x003A, 0x003A}, {0x00B7, 0x00B7},
+	{0x0387, 0x0387}, {0x055F, 0x055F}, {0x05F4, 0x05F4}, {0x2018, 0x2019},
+	{0x2024, 0x2024}, {0x2027, 0x2027}, {0xFE13, 0xFE13}, {0xFE52, 0xFE52},
+	{0xFE55, 0xFE55}, {0xFF07, 0xFF07}, {0xFF0E, 0xFF0E}, {0xFF1A, 0xFF1A},
+}
+
+// The characters of ID_Start and ID_Continue that are not in XID_Start and
+// XID_Continue, because their NFKC normalizations are not identifiers.
+var (
+	xidStartExcluded = []runeRange{
+		{0x037A, 0x037A}, {0x0E33, 0x0E33}, {0x0EB3, 0x0EB3}, {0x309B, 0x309C},
+		{0xFC5E, 0xFC63}, {0xFDFA, 0xFDFB}, {0xFE70, 0xFE70}, {0xFE72, 0xFE72},
+		{0xFE74, 0xFE74}, {0xFE76, 0xFE76}, {0xFE78, 0xFE78}, {0xFE7A, 0xFE7A},
+		{0xFE7C, 0xFE7C}, {0xFE7E, 0xFE7E}, {0xFF9E, 0xFF9F},
+	}
+	xidContinueExcluded = []runeRange{
+		{0x037A, 0x037A}, {0x309B, 0x309C}, {0xFC5E, 0xFC63}, {0xFDFA, 0xFDFB},
+		{0xFE70, 0xFE70}, {0xFE72, 0xFE72}, {0xFE74, 0xFE74}, {0xFE76, 0xFE76},
+		{0xFE78, 0xFE78}, {0xFE7A, 0xFE7A}, {0xFE7C, 0xFE7C}, {0xFE7E, 0xFE7E},
+	}
+)
+
+func isIDStart(ch rune) bool {
+	return unicode.In(ch, unicode.L, unicode.Nl, unicode.Other_ID_Start) &&
+		!unicode.In(ch, unicode.Pattern_Syntax, unicode.Pattern_White_Space)
+}
+
+func isIDContinue(ch rune) bool {
+	return (isIDStart(ch) || unicode.In(ch, unicode.Mn, unicode.Mc, unicode.Nd, unicode.Pc, unicode.Other_ID_Continue)) &&
+		!unicode.In(ch, unicode.Pattern_Syntax, unicode.Pattern_White_Space)
+}
+
+func isDefaultIgnorable(ch rune) bool {
+	switch {
+	case ch >= 0xFFF9 && ch <= 0xFFFB, ch >= 0x13430 && ch <= 0x1343F:
+		return false
+	}
+	return unicode.In(ch, unicode.Other_Default_Ignorable_Code_Point, unicode.Cf, unicode.Variation_Selector) &&
+		!unicode.In(ch, unicode.White_Space, unicode.Prepended_Concatenation_Mark)
+}
+
+func isGraphemeBase(ch rune) bool {
+	return isAssigned(ch) && !unicode.In(ch, unicode.Cc, unicode.Cf, unicode.Cs, unicode.Co, unicode.Zl, unicode.Zp,
+		unicode.Mn, unicode.Me, unicode.Other_Grapheme_Extend)
+}
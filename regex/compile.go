@@ -0,0 +1,658 @@
+package regex
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf16"
+)
+
+// The pattern is compiled to instructions for a backtracking machine. Where
+// the specification continues a match with a continuation, the machine moves
+// on to the next instruction, and where it tries the continuation of another
+// alternative after one fails, the machine resumes a choice point. Choice
+// points and the old values of the slots changed since are kept on a stack in
+// memory, so that the depth of a match does not grow the goroutine stack.
+// https://tc39.es/ecma262/#sec-pattern-semantics
+
+type opcode uint8
+
+const (
+	opMatch opcode = iota
+	opChar
+	opSet
+	opDot
+	opAssert
+	opBackreference
+
+	// opSplit continues at x, or at y when backtracking.
+	opSplit
+	opJump
+
+	// opMark stores the position in slot n, where a group starts.
+	opMark
+	// opCapture sets group n to the span from the position in slot x.
+	opCapture
+
+	// A quantifier counts its iterations in slot n. opLoopInit resets the
+	// count, and opLoop starts an iteration at x or leaves the loop for y.
+	// opIterate starts an iteration, marking its position in slot x and
+	// resetting the captures from lo to hi, and opIterated ends it, failing
+	// an iteration that may be skipped when it matched empty.
+	opLoopInit
+	opLoop
+	opIterate
+	opIterated
+
+	// opStar repeats a single character atom, with one choice point for all
+	// its iterations.
+	opStar
+
+	// opLookaround matches the body at x on its own, continuing at y.
+	opLookaround
+)
+
+type inst struct {
+	op      opcode
+	forward bool
+
+	ch     rune
+	set    *classSet
+	invert bool
+	kind   assertionKind
+
+	n, x, y  int
+	lo, hi   int
+	min, max int
+	greedy   bool
+	negate   bool
+
+	// atom is the character instruction that opStar repeats.
+	atom *inst
+}
+
+type frameKind uint8
+
+const (
+	frameChoice frameKind = iota
+	frameUndo
+	frameGreedy
+	frameLazy
+)
+
+// frame is an entry of the backtrack stack: a choice point to resume at, the
+// old value of a slot to restore, or the state of an opStar loop that gives
+// back or takes another character.
+type frame struct {
+	kind frameKind
+	// pc is the instruction to resume at, or the slot to restore.
+	pc    int32
+	pos   int32
+	value int
+}
+
+// maxStack is the number of frames a match may keep, beyond which it fails
+// with ErrStackOverflow.
+const maxStack = 1 << 22
+
+// stackOverflow is the panic value used to abandon a match that keeps too
+// many frames.
+type stackOverflow struct{}
+
+// machine holds the state of a single match attempt. The slots hold the
+// captures, followed by the positions and counts of groups and quantifiers.
+type machine struct {
+	input []uint16
+	flags Flags
+	insts []inst
+	slots []int
+	stack []frame
+	end   int
+}
+
+func (m *machine) unicode() bool {
+	return m.flags.Unicode || m.flags.UnicodeSets
+}
+
+// read returns the character next to pos in the direction of matching, which
+// in Unicode mode is a whole code point.
+func (m *machine) read(pos int, forward bool) (ch rune, size int, ok bool) {
+	if forward {
+		if pos >= len(m.input) {
+			return 0, 0, false
+		}
+
+		ch = rune(m.input[pos])
+		if m.unicode() && utf16.IsSurrogate(ch) && pos+1 < len(m.input) {
+			if r := utf16.DecodeRune(ch, rune(m.input[pos+1])); r != unicode.ReplacementChar {
+				return r, 2, true
+			}
+		}
+		return ch, 1, true
+	}
+
+	if pos <= 0 {
+		return 0, 0, false
+	}
+
+	ch = rune(m.input[pos-1])
+	if m.unicode() && utf16.IsSurrogate(ch) && pos-2 >= 0 {
+		if r := utf16.DecodeRune(rune(m.input[pos-2]), ch); r != unicode.ReplacementChar {
+			return r, 2, true
+		}
+	}
+	return ch, 1, true
+}
+
+// https://tc39.es/ecma262/#sec-runtime-semantics-canonicalize-ch
+func (m *machine) canonicalize(ch rune) rune {
+	if !m.flags.IgnoreCase {
+		return ch
+	}
+
+	if m.unicode() {
+		// Simple case folding maps all characters of a case folding orbit to
+		// the same character, so the smallest one serves as well.
+		canonical := ch
+		for f := unicode.SimpleFold(ch); f != ch; f = unicode.SimpleFold(f) {
+			if f < canonical {
+				canonical = f
+			}
+		}
+		return canonical
+	}
+
+	upper := unicode.ToUpper(ch)
+	if upper > 0xFFFF || (ch >= 128 && upper < 128) {
+		return ch
+	}
+	return upper
+}
+
+// matchesSet reports whether the set contains a character that is equal to
+// ch after canonicalization.
+// https://tc39.es/ecma262/#sec-runtime-semantics-charactersetmatcher-abstract-operation
+func (m *machine) matchesSet(set *classSet, ch rune) bool {
+	if set.contains(ch) {
+		return true
+	}
+
+	if !m.flags.IgnoreCase {
+		return false
+	}
+
+	canonical := m.canonicalize(ch)
+	for f := unicode.SimpleFold(ch); f != ch; f = unicode.SimpleFold(f) {
+		if m.canonicalize(f) == canonical && set.contains(f) {
+			return true
+		}
+	}
+
+	upper, lower := unicode.ToUpper(ch), unicode.ToLower(ch)
+	return (m.canonicalize(upper) == canonical && set.contains(upper)) ||
+		(m.canonicalize(lower) == canonical && set.contains(lower))
+}
+
+// https://tc39.es/ecma262/#sec-runtime-semantics-iswordchar-abstract-operation
+func (m *machine) isWordChar(pos int) bool {
+	if pos < 0 || pos >= len(m.input) {
+		return false
+	}
+
+	ch := m.input[pos]
+	if (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || (ch >= '0' && ch <= '9') || ch == '_' {
+		return true
+	}
+	return m.unicode() && m.flags.IgnoreCase && (ch == 0x017F || ch == 0x212A)
+}
+
+type compiler struct {
+	flags Flags
+	insts []inst
+	slots int
+}
+
+func (c *compiler) emit(in inst) int {
+	c.insts = append(c.insts, in)
+	return len(c.insts) - 1
+}
+
+func (c *compiler) slot() int {
+	c.slots++
+	return c.slots - 1
+}
+
+// compile emits the instructions of a node, matching either forward or,
+// inside lookbehinds, backward.
+// https://tc39.es/ecma262/#sec-compilesubpattern
+func (c *compiler) compile(n node, forward bool) {
+	switch n := n.(type) {
+	case *disjunction:
+		c.compileAlternatives(len(n.alternatives), func(idx int) {
+			c.compile(n.alternatives[idx], forward)
+		})
+	case *sequence:
+		c.compileSequence(n, forward)
+	case *character:
+		c.emit(inst{op: opChar, ch: n.ch, forward: forward})
+	case *characterClass:
+		c.compileClass(n, forward)
+	case *dot:
+		c.emit(inst{op: opDot, forward: forward})
+	case *assertion:
+		c.emit(inst{op: opAssert, kind: n.kind})
+	case *lookaround:
+		c.compileLookaround(n)
+	case *group:
+		c.compileGroup(n, forward)
+	case *backreference:
+		c.emit(inst{op: opBackreference, n: n.index, forward: forward})
+	case *quantifier:
+		c.compileQuantifier(n, forward)
+	default:
+		panic("unsupported regular expression node")
+	}
+}
+
+// compileAlternatives emits alternatives that are tried in order.
+// https://tc39.es/ecma262/#sec-compilesubpattern
+func (c *compiler) compileAlternatives(count int, alternative func(idx int)) {
+	var jumps []int
+	for idx := 0; idx < count-1; idx++ {
+		split := c.emit(inst{op: opSplit})
+		c.insts[split].x = len(c.insts)
+		alternative(idx)
+		jumps = append(jumps, c.emit(inst{op: opJump}))
+		c.insts[split].y = len(c.insts)
+	}
+	if count > 0 {
+		alternative(count - 1)
+	}
+
+	for _, jump := range jumps {
+		c.insts[jump].x = len(c.insts)
+	}
+}
+
+// Terms of a sequence are matched in reverse order when matching backward.
+// https://tc39.es/ecma262/#sec-compilesubpattern
+func (c *compiler) compileSequence(n *sequence, forward bool) {
+	for idx := range n.terms {
+		if forward {
+			c.compile(n.terms[idx], forward)
+		} else {
+			c.compile(n.terms[len(n.terms)-1-idx], forward)
+		}
+	}
+}
+
+// https://tc39.es/ecma262/#sec-compileatom
+func (c *compiler) compileClass(n *characterClass, forward bool) {
+	single := inst{op: opSet, set: n.set, invert: n.invert, forward: forward}
+	if len(n.set.strings) == 0 {
+		c.emit(single)
+		return
+	}
+
+	// Strings are tried longest first, before single characters, and the
+	// empty string last.
+	strs := make([][]rune, 0, len(n.set.strings))
+	empty := false
+	for _, str := range n.set.strings {
+		if len(str) == 0 {
+			empty = true
+		} else {
+			strs = append(strs, str)
+		}
+	}
+	sort.SliceStable(strs, func(i, j int) bool {
+		return len(strs[i]) > len(strs[j])
+	})
+
+	count := len(strs) + 1
+	if empty {
+		count++
+	}
+	c.compileAlternatives(count, func(idx int) {
+		switch {
+		case idx < len(strs):
+			terms := make([]node, len(strs[idx]))
+			for i, ch := range strs[idx] {
+				terms[i] = &character{ch: ch}
+			}
+			c.compileSequence(&sequence{terms: terms}, forward)
+		case idx == len(strs):
+			c.emit(single)
+		}
+	})
+}
+
+// Lookarounds are atomic: once their body matched, backtracking into it is
+// not possible, but the captures it made are kept.
+// https://tc39.es/ecma262/#sec-compileassertion
+func (c *compiler) compileLookaround(n *lookaround) {
+	look := c.emit(inst{op: opLookaround, negate: n.negate})
+	c.insts[look].x = len(c.insts)
+	c.compile(n.body, !n.behind)
+	c.emit(inst{op: opMatch})
+	c.insts[look].y = len(c.insts)
+}
+
+// https://tc39.es/ecma262/#sec-compileatom
+func (c *compiler) compileGroup(n *group, forward bool) {
+	if n.index == 0 {
+		c.compile(n.body, forward)
+		return
+	}
+
+	mark := c.slot()
+	c.emit(inst{op: opMark, n: mark})
+	c.compile(n.body, forward)
+	c.emit(inst{op: opCapture, n: n.index, x: mark, forward: forward})
+}
+
+// https://tc39.es/ecma262/#sec-runtime-semantics-repeatmatcher-abstract-operation
+func (c *compiler) compileQuantifier(n *quantifier, forward bool) {
+	if atom := c.singleCharacter(n.body, forward); atom != nil {
+		c.emit(inst{op: opStar, atom: atom, min: n.min, max: n.max, greedy: n.greedy})
+		return
+	}
+
+	counter, mark := c.slot(), c.slot()
+	c.emit(inst{op: opLoopInit, n: counter})
+	loop := c.emit(inst{op: opLoop, n: counter, min: n.min, max: n.max, greedy: n.greedy})
+	c.insts[loop].x = len(c.insts)
+
+	// The captures of the quantified atom are reset on every iteration.
+	c.emit(inst{op: opIterate, x: mark, lo: 2 * (n.parenIndex + 1), hi: 2 * (n.parenIndex + n.parenCount + 1)})
+	c.compile(n.body, forward)
+	c.emit(inst{op: opIterated, n: counter, x: mark, min: n.min, y: loop})
+	c.insts[loop].y = len(c.insts)
+}
+
+// singleCharacter returns the instruction of an atom that always matches a
+// single character, which iterations of a quantifier cannot match empty.
+func (c *compiler) singleCharacter(n node, forward bool) *inst {
+	switch n := n.(type) {
+	case *character:
+		return &inst{op: opChar, ch: n.ch, forward: forward}
+	case *characterClass:
+		if len(n.set.strings) == 0 {
+			return &inst{op: opSet, set: n.set, invert: n.invert, forward: forward}
+		}
+	case *dot:
+		return &inst{op: opDot, forward: forward}
+	case *group:
+		if n.index == 0 {
+			return c.singleCharacter(n.body, forward)
+		}
+	}
+	return nil
+}
+
+// run matches from the instruction at pc, returning whether it reached
+// opMatch. On failure the slots are restored and the frames pushed since are
+// gone; on success the frames stay for the caller to backtrack into.
+func (m *machine) run(pc, pos int) bool {
+	base := len(m.stack)
+	for {
+		ok := true
+		in := &m.insts[pc]
+		switch in.op {
+		case opMatch:
+			m.end = pos
+			return true
+		case opChar, opSet, opDot:
+			pos, ok = m.step(in, pos)
+			pc++
+		case opAssert:
+			ok = m.assert(in.kind, pos)
+			pc++
+		case opBackreference:
+			pos, ok = m.backreference(in, pos)
+			pc++
+		case opSplit:
+			m.push(frame{kind: frameChoice, pc: int32(in.y), pos: int32(pos)})
+			pc = in.x
+		case opJump:
+			pc = in.x
+		case opMark:
+			m.set(in.n, pos)
+			pc++
+		case opCapture:
+			if start := m.slots[in.x]; in.forward {
+				m.set(2*in.n, start)
+				m.set(2*in.n+1, pos)
+			} else {
+				m.set(2*in.n, pos)
+				m.set(2*in.n+1, start)
+			}
+			pc++
+		case opLoopInit:
+			m.set(in.n, 0)
+			pc++
+		case opLoop:
+			switch count := m.slots[in.n]; {
+			case count < in.min:
+				pc = in.x
+			case count == in.max:
+				pc = in.y
+			case in.greedy:
+				m.push(frame{kind: frameChoice, pc: int32(in.y), pos: int32(pos)})
+				pc = in.x
+			default:
+				m.push(frame{kind: frameChoice, pc: int32(in.x), pos: int32(pos)})
+				pc = in.y
+			}
+		case opIterate:
+			m.set(in.x, pos)
+			for slot := in.lo; slot < in.hi; slot++ {
+				m.set(slot, -1)
+			}
+			pc++
+		case opIterated:
+			// An iteration of a quantifier that may stop must not match empty.
+			count := m.slots[in.n]
+			if count >= in.min && pos == m.slots[in.x] {
+				ok = false
+			} else {
+				m.set(in.n, count+1)
+				pc = in.y
+			}
+		case opStar:
+			pos, ok = m.star(pc, in, pos)
+			pc++
+		case opLookaround:
+			ok = m.lookaround(in, pos)
+			pc = in.y
+		}
+
+		if ok {
+			continue
+		}
+		if pc, pos, ok = m.backtrack(base); !ok {
+			return false
+		}
+	}
+}
+
+func (m *machine) push(f frame) {
+	if len(m.stack) >= maxStack {
+		panic(stackOverflow{})
+	}
+	m.stack = append(m.stack, f)
+}
+
+// set changes a slot, remembering its old value for backtracking.
+func (m *machine) set(slot, value int) {
+	if m.slots[slot] != value {
+		m.push(frame{kind: frameUndo, pc: int32(slot), value: m.slots[slot]})
+		m.slots[slot] = value
+	}
+}
+
+// backtrack pops frames down to base, restoring the slots they changed, and
+// returns where the next choice point resumes.
+func (m *machine) backtrack(base int) (pc, pos int, ok bool) {
+	for len(m.stack) > base {
+		f := m.stack[len(m.stack)-1]
+		m.stack = m.stack[:len(m.stack)-1]
+
+		switch f.kind {
+		case frameChoice:
+			return int(f.pc), int(f.pos), true
+		case frameUndo:
+			m.slots[f.pc] = f.value
+		case frameGreedy:
+			// Give back the last character of the loop.
+			in := &m.insts[f.pc]
+			_, size, _ := m.read(int(f.pos), !in.atom.forward)
+			pos := advance(int(f.pos), size, !in.atom.forward)
+			if f.value-1 > in.min {
+				m.push(frame{kind: frameGreedy, pc: f.pc, pos: int32(pos), value: f.value - 1})
+			}
+			return int(f.pc) + 1, pos, true
+		case frameLazy:
+			// Take another character into the loop.
+			in := &m.insts[f.pc]
+			pos, ok := m.step(in.atom, int(f.pos))
+			if !ok {
+				continue
+			}
+			if in.max == infinity || f.value+1 < in.max {
+				m.push(frame{kind: frameLazy, pc: f.pc, pos: int32(pos), value: f.value + 1})
+			}
+			return int(f.pc) + 1, pos, true
+		}
+	}
+	return 0, 0, false
+}
+
+// unwind pops the frames down to base, restoring the slots they changed.
+func (m *machine) unwind(base int) {
+	for len(m.stack) > base {
+		if f := m.stack[len(m.stack)-1]; f.kind == frameUndo {
+			m.slots[f.pc] = f.value
+		}
+		m.stack = m.stack[:len(m.stack)-1]
+	}
+}
+
+// step matches a single character instruction at pos.
+func (m *machine) step(in *inst, pos int) (int, bool) {
+	ch, size, ok := m.read(pos, in.forward)
+	if !ok {
+		return pos, false
+	}
+
+	switch in.op {
+	case opChar:
+		ok = m.canonicalize(ch) == m.canonicalize(in.ch)
+	case opSet:
+		ok = m.matchesSet(in.set, ch) != in.invert
+	case opDot:
+		ok = m.flags.DotAll || !isLineTerminator(ch)
+	}
+	return advance(pos, size, in.forward), ok
+}
+
+// star matches the least number of iterations of an opStar loop, or the
+// most when it is greedy, leaving a frame to backtrack to the others.
+func (m *machine) star(pc int, in *inst, pos int) (int, bool) {
+	count := 0
+	for in.max == infinity || count < in.max {
+		if !in.greedy && count == in.min {
+			break
+		}
+
+		next, ok := m.step(in.atom, pos)
+		if !ok {
+			break
+		}
+		pos = next
+		count++
+	}
+
+	if count < in.min {
+		return pos, false
+	}
+	if in.greedy && count > in.min {
+		m.push(frame{kind: frameGreedy, pc: int32(pc), pos: int32(pos), value: count})
+	}
+	if !in.greedy && (in.max == infinity || count < in.max) {
+		m.push(frame{kind: frameLazy, pc: int32(pc), pos: int32(pos), value: count})
+	}
+	return pos, true
+}
+
+// https://tc39.es/ecma262/#sec-compileassertion
+func (m *machine) assert(kind assertionKind, pos int) bool {
+	switch kind {
+	case assertionStart:
+		return pos == 0 || (m.flags.Multiline && isLineTerminator(rune(m.input[pos-1])))
+	case assertionEnd:
+		return pos == len(m.input) || (m.flags.Multiline && isLineTerminator(rune(m.input[pos])))
+	case assertionWordBoundary:
+		return m.isWordChar(pos-1) != m.isWordChar(pos)
+	default:
+		return m.isWordChar(pos-1) == m.isWordChar(pos)
+	}
+}
+
+// lookaround matches the body of a lookaround on its own. Only the frames
+// restoring the captures it set are kept, so that backtracking past the
+// lookaround restores them.
+// https://tc39.es/ecma262/#sec-compileassertion
+func (m *machine) lookaround(in *inst, pos int) bool {
+	base := len(m.stack)
+	matched := m.run(in.x, pos)
+
+	if in.negate {
+		m.unwind(base)
+		return !matched
+	}
+	if !matched {
+		return false
+	}
+
+	kept := base
+	for _, f := range m.stack[base:] {
+		if f.kind == frameUndo {
+			m.stack[kept] = f
+			kept++
+		}
+	}
+	m.stack = m.stack[:kept]
+	return true
+}
+
+// https://tc39.es/ecma262/#sec-backreference-matcher
+func (m *machine) backreference(in *inst, pos int) (int, bool) {
+	start, end := m.slots[2*in.n], m.slots[2*in.n+1]
+	if start < 0 {
+		return pos, true
+	}
+
+	length := end - start
+	from := pos
+	if !in.forward {
+		from = pos - length
+	}
+
+	if from < 0 || from+length > len(m.input) {
+		return pos, false
+	}
+
+	for idx := 0; idx < length; idx++ {
+		if m.canonicalize(rune(m.input[start+idx])) != m.canonicalize(rune(m.input[from+idx])) {
+			return pos, false
+		}
+	}
+	return advance(pos, length, in.forward), true
+}
+
+func advance(pos, size int, forward bool) int {
+	if forward {
+		return pos + size
+	}
+	return pos - size
+}
@@ -0,0 +1,1005 @@
+package regex
+
+import (
+	"strconv"
+	"unicode"
+	"unicode/utf16"
+)
+
+// syntaxError is the panic value used to abandon parsing an invalid pattern.
+type syntaxError string
+
+type node interface{}
+
+type (
+	sequence struct {
+		terms []node
+	}
+	disjunction struct {
+		alternatives []node
+	}
+	character struct {
+		ch rune
+	}
+	characterClass struct {
+		set    *classSet
+		invert bool
+	}
+	dot struct{}
+
+	assertion struct {
+		kind assertionKind
+	}
+	lookaround struct {
+		body           node
+		behind, negate bool
+	}
+	group struct {
+		body node
+		// index is the number of a capturing group, or 0.
+		index int
+	}
+	backreference struct {
+		index int
+		name  string
+	}
+	quantifier struct {
+		body                   node
+		min, max               int
+		greedy                 bool
+		parenIndex, parenCount int
+	}
+)
+
+type assertionKind int
+
+const (
+	assertionStart assertionKind = iota
+	assertionEnd
+	assertionWordBoundary
+	assertionNotWordBoundary
+)
+
+// infinity is the maximum of an unbounded quantifier.
+const infinity = -1
+
+// https://tc39.es/ecma262/#sec-patterns
+type parser struct {
+	pattern []rune
+	pos     int
+
+	// unicode is set in Unicode mode, for either the u or the v flag.
+	unicode    bool
+	sets       bool
+	ignoreCase bool
+
+	// groupCount and namedGroups are found by scanning the whole pattern
+	// first, since backreferences may precede the groups they refer to.
+	groupCount  int
+	namedGroups bool
+
+	groupNames []string
+	namedRefs  []*backreference
+}
+
+func newParser(pattern string, f Flags) *parser {
+	p := &parser{unicode: f.Unicode || f.UnicodeSets, sets: f.UnicodeSets, ignoreCase: f.IgnoreCase}
+
+	// Outside of Unicode mode, the pattern is a sequence of code units.
+	if p.unicode {
+		p.pattern = []rune(pattern)
+	} else {
+		for _, unit := range utf16.Encode([]rune(pattern)) {
+			p.pattern = append(p.pattern, rune(unit))
+		}
+	}
+
+	p.groupNames = []string{""}
+	p.countGroups()
+	return p
+}
+
+func (p *parser) fail(msg string) {
+	panic(syntaxError(msg))
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.pattern)
+}
+
+func (p *parser) peek() rune {
+	if p.eof() {
+		return -1
+	}
+	return p.pattern[p.pos]
+}
+
+func (p *parser) peekAt(offset int) rune {
+	if p.pos+offset >= len(p.pattern) {
+		return -1
+	}
+	return p.pattern[p.pos+offset]
+}
+
+func (p *parser) next() rune {
+	ch := p.peek()
+	p.pos++
+	return ch
+}
+
+func (p *parser) eat(ch rune) bool {
+	if p.peek() == ch {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) lookingAt(s string) bool {
+	for idx, ch := range s {
+		if p.peekAt(idx) != ch {
+			return false
+		}
+	}
+	return true
+}
+
+// countGroups finds the number of capturing groups and whether any of them
+// is named.
+// https://tc39.es/ecma262/#sec-countleftcapturingparenswithin
+func (p *parser) countGroups() {
+	classDepth := 0
+	for idx := 0; idx < len(p.pattern); idx++ {
+		switch p.pattern[idx] {
+		case '\\':
+			idx++
+		case '[':
+			// Classes only nest with the v flag.
+			if p.sets || classDepth == 0 {
+				classDepth++
+			}
+		case ']':
+			if classDepth > 0 {
+				classDepth--
+			}
+		case '(':
+			if classDepth > 0 {
+				continue
+			}
+
+			if idx+1 >= len(p.pattern) || p.pattern[idx+1] != '?' {
+				p.groupCount++
+			} else if idx+3 < len(p.pattern) && p.pattern[idx+2] == '<' &&
+				p.pattern[idx+3] != '=' && p.pattern[idx+3] != '!' {
+				p.groupCount++
+				p.namedGroups = true
+			}
+		}
+	}
+}
+
+// https://tc39.es/ecma262/#prod-Pattern
+func (p *parser) parsePattern() node {
+	n := p.parseDisjunction()
+	if !p.eof() {
+		p.fail("Unmatched ')'")
+	}
+
+	for _, ref := range p.namedRefs {
+		for idx, name := range p.groupNames {
+			if name == ref.name {
+				ref.index = idx
+			}
+		}
+
+		if ref.index == 0 {
+			p.fail("Invalid named capture referenced")
+		}
+	}
+	return n
+}
+
+// https://tc39.es/ecma262/#prod-Disjunction
+func (p *parser) parseDisjunction() node {
+	alternatives := []node{p.parseAlternative()}
+	for p.eat('|') {
+		alternatives = append(alternatives, p.parseAlternative())
+	}
+
+	if len(alternatives) == 1 {
+		return alternatives[0]
+	}
+	return &disjunction{alternatives: alternatives}
+}
+
+// https://tc39.es/ecma262/#prod-Alternative
+func (p *parser) parseAlternative() node {
+	var terms []node
+	for !p.eof() && p.peek() != '|' && p.peek() != ')' {
+		terms = append(terms, p.parseTerm())
+	}
+	return &sequence{terms: terms}
+}
+
+// https://tc39.es/ecma262/#prod-Term
+func (p *parser) parseTerm() node {
+	parenIndex := len(p.groupNames) - 1
+	atom, quantifiable := p.parseAtom()
+
+	q := p.parseQuantifier()
+	if q == nil {
+		return atom
+	}
+
+	if !quantifiable {
+		p.fail("Nothing to repeat")
+	}
+
+	q.body = atom
+	q.parenIndex = parenIndex
+	q.parenCount = len(p.groupNames) - 1 - parenIndex
+	return q
+}
+
+// https://tc39.es/ecma262/#prod-Quantifier
+func (p *parser) parseQuantifier() *quantifier {
+	q := &quantifier{greedy: true}
+	switch p.peek() {
+	case '*':
+		p.pos++
+		q.min, q.max = 0, infinity
+	case '+':
+		p.pos++
+		q.min, q.max = 1, infinity
+	case '?':
+		p.pos++
+		q.min, q.max = 0, 1
+	case '{':
+		min, max, ok := p.parseBracedQuantifier()
+		if !ok {
+			if p.unicode {
+				p.fail("Incomplete quantifier")
+			}
+			// Annex B: the brace is a pattern character.
+			return nil
+		}
+		q.min, q.max = min, max
+	default:
+		return nil
+	}
+
+	if p.eat('?') {
+		q.greedy = false
+	}
+	return q
+}
+
+// parseBracedQuantifier parses {n}, {n,} or {n,m}. On failure the position
+// is left unchanged.
+func (p *parser) parseBracedQuantifier() (min, max int, ok bool) {
+	start := p.pos
+	p.pos++
+
+	min, ok = p.parseDecimalDigits()
+	if !ok {
+		p.pos = start
+		return 0, 0, false
+	}
+
+	max = min
+	if p.eat(',') {
+		max = infinity
+		if n, ok := p.parseDecimalDigits(); ok {
+			max = n
+		}
+	}
+
+	if p.peek() != '}' {
+		p.pos = start
+		return 0, 0, false
+	}
+	p.pos++
+
+	if max != infinity && max < min {
+		p.fail("numbers out of order in {} quantifier")
+	}
+	return min, max, true
+}
+
+// parseDecimalDigits parses a decimal number, saturating values that do not
+// fit into an int32.
+func (p *parser) parseDecimalDigits() (int, bool) {
+	start := p.pos
+	n := 0
+	for p.peek() >= '0' && p.peek() <= '9' {
+		if n < 1<<31 {
+			n = n*10 + int(p.next()-'0')
+		} else {
+			p.pos++
+		}
+	}
+	return n, p.pos > start
+}
+
+// parseAtom parses an Assertion or an Atom, reporting whether it may be
+// followed by a quantifier.
+// https://tc39.es/ecma262/#prod-Atom
+// https://tc39.es/ecma262/#prod-Assertion
+func (p *parser) parseAtom() (node, bool) {
+	ch := p.next()
+	switch ch {
+	case '^':
+		return &assertion{kind: assertionStart}, false
+	case '$':
+		return &assertion{kind: assertionEnd}, false
+	case '.':
+		return &dot{}, true
+	case '(':
+		return p.parseGroup()
+	case '[':
+		return p.parseClass(), true
+	case '*', '+', '?':
+		p.fail("Nothing to repeat")
+	case '{':
+		if p.unicode {
+			p.fail("Lone quantifier brackets")
+		}
+
+		// https://tc39.es/ecma262/#prod-annexB-InvalidBracedQuantifier
+		p.pos--
+		if _, _, ok := p.parseBracedQuantifier(); ok {
+			p.fail("Nothing to repeat")
+		}
+		p.pos++
+		return &character{ch: '{'}, true
+	case '}', ']':
+		if p.unicode {
+			p.fail("Lone quantifier brackets")
+		}
+		return &character{ch: ch}, true
+	case '\\':
+		switch p.peek() {
+		case 'b':
+			p.pos++
+			return &assertion{kind: assertionWordBoundary}, false
+		case 'B':
+			p.pos++
+			return &assertion{kind: assertionNotWordBoundary}, false
+		}
+		return p.parseAtomEscape(), true
+	}
+
+	return &character{ch: ch}, true
+}
+
+// https://tc39.es/ecma262/#prod-Atom
+func (p *parser) parseGroup() (node, bool) {
+	if !p.eat('?') {
+		index := len(p.groupNames)
+		p.groupNames = append(p.groupNames, "")
+		return &group{body: p.parseGroupBody(), index: index}, true
+	}
+
+	switch {
+	case p.eat(':'):
+		return &group{body: p.parseGroupBody()}, true
+	case p.eat('='):
+		// Annex B allows quantified lookaheads outside of Unicode mode.
+		return &lookaround{body: p.parseGroupBody()}, !p.unicode
+	case p.eat('!'):
+		return &lookaround{body: p.parseGroupBody(), negate: true}, !p.unicode
+	case p.lookingAt("<="):
+		p.pos += 2
+		return &lookaround{body: p.parseGroupBody(), behind: true}, false
+	case p.lookingAt("<!"):
+		p.pos += 2
+		return &lookaround{body: p.parseGroupBody(), behind: true, negate: true}, false
+	case p.peek() == '<':
+		name := p.parseGroupName()
+		for _, existing := range p.groupNames {
+			if existing == name {
+				p.fail("Duplicate capture group name")
+			}
+		}
+
+		index := len(p.groupNames)
+		p.groupNames = append(p.groupNames, name)
+		return &group{body: p.parseGroupBody(), index: index}, true
+	}
+
+	p.fail("Invalid group")
+	return nil, false
+}
+
+func (p *parser) parseGroupBody() node {
+	body := p.parseDisjunction()
+	if !p.eat(')') {
+		p.fail("Unterminated group")
+	}
+	return body
+}
+
+// https://tc39.es/ecma262/#prod-GroupName
+func (p *parser) parseGroupName() string {
+	if !p.eat('<') {
+		p.fail("Invalid capture group name")
+	}
+
+	var name []rune
+	for !p.eat('>') {
+		if p.eof() {
+			p.fail("Invalid capture group name")
+		}
+
+		ch := p.next()
+		if ch == '\\' {
+			if !p.eat('u') {
+				p.fail("Invalid Unicode escape")
+			}
+			ch = p.parseUnicodeEscape(true)
+		} else if !p.unicode && ch >= 0xD800 && ch <= 0xDBFF && p.peek() >= 0xDC00 && p.peek() <= 0xDFFF {
+			ch = utf16.DecodeRune(ch, p.next())
+		}
+
+		if !isIdentifierPart(ch) || (len(name) == 0 && !isIdentifierStart(ch)) {
+			p.fail("Invalid capture group name")
+		}
+		name = append(name, ch)
+	}
+
+	if len(name) == 0 {
+		p.fail("Invalid capture group name")
+	}
+	return string(name)
+}
+
+// https://tc39.es/ecma262/#prod-AtomEscape
+func (p *parser) parseAtomEscape() node {
+	if p.eof() {
+		p.fail("\\ at end of pattern")
+	}
+
+	ch := p.peek()
+	switch {
+	case ch >= '1' && ch <= '9':
+		start := p.pos
+		n, _ := p.parseDecimalDigits()
+		if n <= p.groupCount {
+			return &backreference{index: n}
+		}
+
+		if p.unicode {
+			p.fail("Invalid escape")
+		}
+
+		// https://tc39.es/ecma262/#prod-annexB-CharacterEscape
+		p.pos = start
+		if ch >= '8' {
+			p.pos++
+			return &character{ch: ch}
+		}
+		return &character{ch: p.parseLegacyOctalEscape()}
+	case ch == 'k' && (p.unicode || p.namedGroups):
+		p.pos++
+		ref := &backreference{name: p.parseGroupName()}
+		p.namedRefs = append(p.namedRefs, ref)
+		return ref
+	}
+
+	if set, ok := p.parseClassEscape(); ok {
+		return &characterClass{set: set}
+	}
+	return &character{ch: p.parseCharacterEscape(false)}
+}
+
+// parseClassEscape parses a CharacterClassEscape.
+// https://tc39.es/ecma262/#prod-CharacterClassEscape
+func (p *parser) parseClassEscape() (*classSet, bool) {
+	switch ch := p.peek(); ch {
+	case 'd', 'D', 's', 'S', 'w', 'W':
+		p.pos++
+		return builtinClass(ch, p.unicode && p.ignoreCase), true
+	case 'p', 'P':
+		if !p.unicode {
+			return nil, false
+		}
+
+		p.pos++
+		set := p.parsePropertyEscape()
+		if ch == 'P' {
+			if len(set.strings) > 0 {
+				p.fail("Invalid property name")
+			}
+			set = set.complement()
+		}
+		return set, true
+	}
+	return nil, false
+}
+
+// https://tc39.es/ecma262/#prod-UnicodePropertyValueExpression
+func (p *parser) parsePropertyEscape() *classSet {
+	if !p.eat('{') {
+		p.fail("Invalid property name")
+	}
+
+	var name, value []rune
+	target := &name
+	for !p.eat('}') {
+		ch := p.next()
+		switch {
+		case ch == -1:
+			p.fail("Invalid property name")
+		case ch == '=' && target == &name:
+			target = &value
+		case ch == '_' || (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || (ch >= '0' && ch <= '9'):
+			*target = append(*target, ch)
+		default:
+			p.fail("Invalid property name")
+		}
+	}
+
+	set, ok := unicodeProperty(string(name), string(value), target == &value, p.sets)
+	if !ok {
+		p.fail("Invalid property name")
+	}
+	return set
+}
+
+// parseCharacterEscape parses the escape following a backslash.
+// https://tc39.es/ecma262/#prod-CharacterEscape
+func (p *parser) parseCharacterEscape(inClass bool) rune {
+	ch := p.next()
+	switch ch {
+	case -1:
+		p.fail("\\ at end of pattern")
+	case 'f':
+		return '\f'
+	case 'n':
+		return '\n'
+	case 'r':
+		return '\r'
+	case 't':
+		return '\t'
+	case 'v':
+		return '\v'
+	case 'c':
+		if letter := p.peek(); (letter >= 'a' && letter <= 'z') || (letter >= 'A' && letter <= 'Z') {
+			p.pos++
+			return letter % 32
+		}
+
+		if p.unicode {
+			p.fail("Invalid unicode escape")
+		}
+
+		// https://tc39.es/ecma262/#prod-annexB-ClassControlLetter
+		if letter := p.peek(); inClass && ((letter >= '0' && letter <= '9') || letter == '_') {
+			p.pos++
+			return letter % 32
+		}
+
+		// Otherwise the backslash stands for itself.
+		p.pos--
+		return '\\'
+	case '0':
+		if next := p.peek(); next < '0' || next > '9' {
+			return 0
+		}
+
+		if p.unicode {
+			p.fail("Invalid decimal escape")
+		}
+		p.pos--
+		return p.parseLegacyOctalEscape()
+	case 'x':
+		if h1, h2 := hexValue(p.peek()), hexValue(p.peekAt(1)); h1 >= 0 && h2 >= 0 {
+			p.pos += 2
+			return rune(h1*16 + h2)
+		}
+
+		if p.unicode {
+			p.fail("Invalid escape")
+		}
+		return 'x'
+	case 'u':
+		return p.parseUnicodeEscape(p.unicode)
+	}
+
+	if p.unicode {
+		if isSyntaxCharacter(ch) || ch == '/' || (inClass && ch == '-') {
+			return ch
+		}
+		p.fail("Invalid escape")
+	}
+
+	if ch >= '1' && ch <= '9' && inClass {
+		// https://tc39.es/ecma262/#prod-annexB-ClassEscape
+		if ch >= '8' {
+			return ch
+		}
+		p.pos--
+		return p.parseLegacyOctalEscape()
+	}
+
+	if ch == 'k' && p.namedGroups {
+		p.fail("Invalid named reference")
+	}
+	return ch
+}
+
+// parseUnicodeEscape parses the escape following \u. In Unicode mode, \u{...}
+// is accepted and escaped surrogate pairs are combined.
+// https://tc39.es/ecma262/#prod-RegExpUnicodeEscapeSequence
+func (p *parser) parseUnicodeEscape(unicodeMode bool) rune {
+	if unicodeMode && p.eat('{') {
+		code, digits := 0, 0
+		for !p.eat('}') {
+			h := hexValue(p.next())
+			if h < 0 {
+				p.fail("Invalid Unicode escape")
+			}
+
+			code = code*16 + h
+			digits++
+			if code > unicode.MaxRune {
+				p.fail("Invalid Unicode escape")
+			}
+		}
+
+		if digits == 0 {
+			p.fail("Invalid Unicode escape")
+		}
+		return rune(code)
+	}
+
+	lead, ok := p.parseHex4()
+	if !ok {
+		if unicodeMode {
+			p.fail("Invalid Unicode escape")
+		}
+		return 'u'
+	}
+
+	if unicodeMode && lead >= 0xD800 && lead <= 0xDBFF && p.lookingAt("\\u") {
+		start := p.pos
+		p.pos += 2
+		if trail, ok := p.parseHex4(); ok && trail >= 0xDC00 && trail <= 0xDFFF {
+			return utf16.DecodeRune(lead, trail)
+		}
+		p.pos = start
+	}
+	return lead
+}
+
+func (p *parser) parseHex4() (rune, bool) {
+	code := 0
+	for idx := 0; idx < 4; idx++ {
+		h := hexValue(p.peekAt(idx))
+		if h < 0 {
+			return 0, false
+		}
+		code = code*16 + h
+	}
+	p.pos += 4
+	return rune(code), true
+}
+
+// https://tc39.es/ecma262/#prod-annexB-LegacyOctalEscapeSequence
+func (p *parser) parseLegacyOctalEscape() rune {
+	first := p.next()
+	code := first - '0'
+	if !isOctalDigit(p.peek()) {
+		return code
+	}
+
+	code = code*8 + p.next() - '0'
+	if first <= '3' && isOctalDigit(p.peek()) {
+		code = code*8 + p.next() - '0'
+	}
+	return code
+}
+
+// https://tc39.es/ecma262/#prod-CharacterClass
+func (p *parser) parseClass() node {
+	invert := p.eat('^')
+	if p.sets {
+		set := p.parseClassSetExpression()
+		if !p.eat(']') {
+			p.fail("Unterminated character class")
+		}
+
+		if invert {
+			if len(set.strings) > 0 {
+				p.fail("Negated character class may contain strings")
+			}
+			set = set.complement()
+		}
+		return &characterClass{set: set}
+	}
+
+	set := &classSet{}
+	for !p.eat(']') {
+		if p.eof() {
+			p.fail("Unterminated character class")
+		}
+
+		lo, loSet := p.parseClassAtom()
+		if p.peek() != '-' || p.peekAt(1) == ']' || p.peekAt(1) == -1 {
+			set.addAtom(lo, loSet)
+			continue
+		}
+		p.pos++
+
+		hi, hiSet := p.parseClassAtom()
+		if loSet != nil || hiSet != nil {
+			if p.unicode {
+				p.fail("Invalid character class")
+			}
+
+			// https://tc39.es/ecma262/#prod-annexB-NonemptyClassRangesNoDash
+			set.addAtom(lo, loSet)
+			set.addRange('-', '-')
+			set.addAtom(hi, hiSet)
+			continue
+		}
+
+		if lo > hi {
+			p.fail("Range out of order in character class")
+		}
+		set.addRange(lo, hi)
+	}
+	return &characterClass{set: set, invert: invert}
+}
+
+// parseClassAtom parses a single character or a character class escape.
+// https://tc39.es/ecma262/#prod-ClassAtom
+func (p *parser) parseClassAtom() (rune, *classSet) {
+	ch := p.next()
+	if ch != '\\' {
+		return ch, nil
+	}
+
+	switch p.peek() {
+	case 'b':
+		p.pos++
+		return '\b', nil
+	case -1:
+		p.fail("\\ at end of pattern")
+	}
+
+	if set, ok := p.parseClassEscape(); ok {
+		return 0, set
+	}
+	return p.parseCharacterEscape(true), nil
+}
+
+// https://tc39.es/ecma262/#prod-ClassSetExpression
+func (p *parser) parseClassSetExpression() *classSet {
+	if p.peek() == ']' {
+		return &classSet{}
+	}
+
+	set := p.parseClassSetRange()
+	for _, op := range []string{"&&", "--"} {
+		if !p.lookingAt(op) {
+			continue
+		}
+
+		for p.lookingAt(op) {
+			p.pos += 2
+			if p.lookingAt("&") {
+				p.fail("Invalid set operation in character class")
+			}
+
+			operand := p.parseClassSetOperand()
+			if op == "&&" {
+				set = set.intersect(operand)
+			} else {
+				set = set.subtract(operand)
+			}
+		}
+
+		if p.peek() != ']' {
+			p.fail("Invalid set operation in character class")
+		}
+		return set
+	}
+
+	// https://tc39.es/ecma262/#prod-ClassUnion
+	for p.peek() != ']' {
+		if p.eof() {
+			p.fail("Unterminated character class")
+		}
+
+		if p.lookingAt("&&") || p.lookingAt("--") {
+			p.fail("Invalid set operation in character class")
+		}
+		set = set.union(p.parseClassSetRange())
+	}
+	return set
+}
+
+// parseClassSetRange parses a ClassSetOperand or a ClassSetRange.
+// https://tc39.es/ecma262/#prod-ClassSetRange
+func (p *parser) parseClassSetRange() *classSet {
+	start := p.pos
+	lo, ok := p.parseClassSetCharacter()
+	if !ok {
+		p.pos = start
+		return p.parseClassSetOperand()
+	}
+
+	if p.peek() != '-' || p.lookingAt("--") {
+		return classSetOf(lo)
+	}
+	p.pos++
+
+	hi, ok := p.parseClassSetCharacter()
+	if !ok {
+		p.fail("Invalid character class")
+	}
+
+	if lo > hi {
+		p.fail("Range out of order in character class")
+	}
+
+	set := &classSet{}
+	set.addRange(lo, hi)
+	return set
+}
+
+// https://tc39.es/ecma262/#prod-ClassSetOperand
+func (p *parser) parseClassSetOperand() *classSet {
+	switch {
+	case p.eat('['):
+		invert := p.eat('^')
+		set := p.parseClassSetExpression()
+		if !p.eat(']') {
+			p.fail("Unterminated character class")
+		}
+
+		if invert {
+			if len(set.strings) > 0 {
+				p.fail("Negated character class may contain strings")
+			}
+			set = set.complement()
+		}
+		return set
+	case p.lookingAt("\\q{"):
+		p.pos += 3
+		return p.parseClassStringDisjunction()
+	case p.peek() == '\\':
+		start := p.pos
+		p.pos++
+		if set, ok := p.parseClassEscape(); ok {
+			return set
+		}
+		p.pos = start
+	}
+
+	ch, ok := p.parseClassSetCharacter()
+	if !ok {
+		p.fail("Invalid character in character class")
+	}
+	return classSetOf(ch)
+}
+
+// https://tc39.es/ecma262/#prod-ClassStringDisjunctionContents
+func (p *parser) parseClassStringDisjunction() *classSet {
+	set := &classSet{}
+	var str []rune
+	for {
+		switch {
+		case p.eof():
+			p.fail("Unterminated character class")
+		case p.eat('}'):
+			set.addString(str)
+			return set
+		case p.eat('|'):
+			set.addString(str)
+			str = nil
+		default:
+			ch, ok := p.parseClassSetCharacter()
+			if !ok {
+				p.fail("Invalid character in character class")
+			}
+			str = append(str, ch)
+		}
+	}
+}
+
+// https://tc39.es/ecma262/#prod-ClassSetCharacter
+func (p *parser) parseClassSetCharacter() (rune, bool) {
+	ch := p.peek()
+	if ch == -1 {
+		return 0, false
+	}
+
+	if ch != '\\' {
+		if isClassSetSyntaxCharacter(ch) || (isClassSetReservedDoublePunctuator(ch) && p.peekAt(1) == ch) {
+			return 0, false
+		}
+		p.pos++
+		return ch, true
+	}
+
+	switch next := p.peekAt(1); {
+	case next == 'b':
+		p.pos += 2
+		return '\b', true
+	case isClassSetReservedPunctuator(next):
+		p.pos += 2
+		return next, true
+	case next == 'd' || next == 'D' || next == 's' || next == 'S' || next == 'w' || next == 'W' ||
+		next == 'p' || next == 'P' || next == 'q':
+		return 0, false
+	}
+
+	p.pos++
+	return p.parseCharacterEscape(true), true
+}
+
+// https://tc39.es/ecma262/#prod-SyntaxCharacter
+func isSyntaxCharacter(ch rune) bool {
+	switch ch {
+	case '^', '$', '\\', '.', '*', '+', '?', '(', ')', '[', ']', '{', '}', '|':
+		return true
+	}
+	return false
+}
+
+// https://tc39.es/ecma262/#prod-ClassSetSyntaxCharacter
+func isClassSetSyntaxCharacter(ch rune) bool {
+	switch ch {
+	case '(', ')', '[', ']', '{', '}', '/', '-', '\\', '|':
+		return true
+	}
+	return false
+}
+
+// https://tc39.es/ecma262/#prod-ClassSetReservedDoublePunctuator
+func isClassSetReservedDoublePunctuator(ch rune) bool {
+	switch ch {
+	case '&', '!', '#', '$', '%', '*', '+', ',', '.', ':', ';', '<', '=', '>', '?', '@', '^', '`', '~':
+		return true
+	}
+	return false
+}
+
+// https://tc39.es/ecma262/#prod-ClassSetReservedPunctuator
+func isClassSetReservedPunctuator(ch rune) bool {
+	switch ch {
+	case '&', '-', '!', '#', '%', ',', ':', ';', '<', '=', '>', '@', '`', '~':
+		return true
+	}
+	return false
+}
+
+func isOctalDigit(ch rune) bool {
+	return ch >= '0' && ch <= '7'
+}
+
+func hexValue(ch rune) int {
+	if ch < 0 || ch > 'f' {
+		return -1
+	}
+
+	v, err := strconv.ParseUint(string(ch), 16, 8)
+	if err != nil {
+		return -1
+	}
+	return int(v)
+}
+
+// https://tc39.es/ecma262/#prod-IdentifierStartChar
+func isIdentifierStart(ch rune) bool {
+	return ch == '$' || ch == '_' || unicode.IsLetter(ch) || unicode.Is(unicode.Nl, ch) ||
+		unicode.Is(unicode.Other_ID_Start, ch)
+}
+
+// https://tc39.es/ecma262/#prod-IdentifierPartChar
+func isIdentifierPart(ch rune) bool {
+	return isIdentifierStart(ch) || ch == '\u200C' || ch == '\u200D' ||
+		unicode.In(ch, unicode.Mn, unicode.Mc, unicode.Nd, unicode.Pc, unicode.Other_ID_Continue)
+}
@@ -0,0 +1,195 @@
+// Package regex implements ECMAScript regular expressions with a backtracking
+// matcher that follows the pattern semantics of the specification.
+// https://tc39.es/ecma262/#sec-regexp-regular-expression-objects
+package regex
+
+import (
+	"errors"
+	"fmt"
+)
+
+// https://tc39.es/ecma262/#sec-regexp.prototype.flags
+type Flags struct {
+	HasIndices  bool // d
+	Global      bool // g
+	IgnoreCase  bool // i
+	Multiline   bool // m
+	DotAll      bool // s
+	Unicode     bool // u
+	UnicodeSets bool // v
+	Sticky      bool // y
+}
+
+// ParseFlags validates the flags of a regular expression, which may contain
+// each of the characters "dgimsuvy" once, but not both u and v.
+func ParseFlags(flags string) (Flags, error) {
+	var f Flags
+	for _, ch := range flags {
+		var flag *bool
+		switch ch {
+		case 'd':
+			flag = &f.HasIndices
+		case 'g':
+			flag = &f.Global
+		case 'i':
+			flag = &f.IgnoreCase
+		case 'm':
+			flag = &f.Multiline
+		case 's':
+			flag = &f.DotAll
+		case 'u':
+			flag = &f.Unicode
+		case 'v':
+			flag = &f.UnicodeSets
+		case 'y':
+			flag = &f.Sticky
+		}
+
+		if flag == nil || *flag {
+			return Flags{}, fmt.Errorf("Invalid regular expression flags '%s'", flags)
+		}
+		*flag = true
+	}
+
+	if f.Unicode && f.UnicodeSets {
+		return Flags{}, fmt.Errorf("Invalid regular expression flags '%s'", flags)
+	}
+	return f, nil
+}
+
+// String returns the flags in the order of RegExp.prototype.flags.
+func (f Flags) String() string {
+	var b []byte
+	for _, flag := range []struct {
+		set bool
+		ch  byte
+	}{
+		{f.HasIndices, 'd'},
+		{f.Global, 'g'},
+		{f.IgnoreCase, 'i'},
+		{f.Multiline, 'm'},
+		{f.DotAll, 's'},
+		{f.Unicode, 'u'},
+		{f.UnicodeSets, 'v'},
+		{f.Sticky, 'y'},
+	} {
+		if flag.set {
+			b = append(b, flag.ch)
+		}
+	}
+	return string(b)
+}
+
+// SyntaxError reports a pattern that is not a valid regular expression.
+type SyntaxError struct {
+	Pattern, Flags string
+	Message        string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("Invalid regular expression: /%s/%s: %s", e.Pattern, e.Flags, e.Message)
+}
+
+// ErrStackOverflow reports a match that backtracks through more choice
+// points than the machine keeps.
+var ErrStackOverflow = errors.New("Maximum call stack size exceeded")
+
+// Regexp is a compiled regular expression.
+type Regexp struct {
+	Source string
+	Flags  Flags
+
+	groupNames []string
+	insts      []inst
+	slots      int
+}
+
+// Compile parses the pattern with the given flags.
+// https://tc39.es/ecma262/#sec-parsepattern
+func Compile(pattern string, flags string) (re *Regexp, err error) {
+	f, err := ParseFlags(flags)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			msg, ok := r.(syntaxError)
+			if !ok {
+				panic(r)
+			}
+			re, err = nil, &SyntaxError{Pattern: pattern, Flags: flags, Message: string(msg)}
+		}
+	}()
+
+	p := newParser(pattern, f)
+	n := p.parsePattern()
+
+	c := &compiler{flags: f, slots: 2 * len(p.groupNames)}
+	c.compile(n, true)
+	c.emit(inst{op: opMatch})
+	return &Regexp{
+		Source:     pattern,
+		Flags:      f,
+		groupNames: p.groupNames,
+		insts:      c.insts,
+		slots:      c.slots,
+	}, nil
+}
+
+// GroupCount returns the number of capturing groups.
+func (re *Regexp) GroupCount() int {
+	return len(re.groupNames) - 1
+}
+
+// GroupNames returns the name of every capturing group, indexed by group
+// number, with an empty name for unnamed groups. The name of group 0, the
+// whole match, is always empty.
+func (re *Regexp) GroupNames() []string {
+	return re.groupNames
+}
+
+// HasNamedGroups reports whether the pattern contains a named group.
+func (re *Regexp) HasNamedGroups() bool {
+	for _, name := range re.groupNames {
+		if name != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchAt matches the expression against the UTF-16 input starting exactly
+// at index. It returns the start and end index of the match and of every
+// capturing group, with -1 for groups that did not participate, or nil when
+// there is no match at index. It fails with ErrStackOverflow when the match
+// needs to keep too many choice points.
+// https://tc39.es/ecma262/#sec-compilepattern
+func (re *Regexp) MatchAt(input []uint16, index int) (caps []int, err error) {
+	m := &machine{
+		input: input,
+		flags: re.Flags,
+		insts: re.insts,
+		slots: make([]int, re.slots),
+	}
+	for idx := range m.slots {
+		m.slots[idx] = -1
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(stackOverflow); !ok {
+				panic(r)
+			}
+			caps, err = nil, ErrStackOverflow
+		}
+	}()
+
+	if !m.run(0, index) {
+		return nil, nil
+	}
+
+	caps = m.slots[:2*len(re.groupNames)]
+	caps[0], caps[1] = index, m.end
+	return caps, nil
+}
@@ -0,0 +1,264 @@
+package regex
+
+import (
+	"reflect"
+	"testing"
+	"unicode/utf16"
+)
+
+// exec matches the pattern at the first index of the input it matches at,
+// returning the text of the match and of every group, with "-" for groups
+// that did not participate, or nil when it does not match.
+func exec(t *testing.T, pattern, flags, input string) []string {
+	t.Helper()
+
+	re, err := Compile(pattern, flags)
+	if err != nil {
+		t.Fatalf("/%s/%s: %v", pattern, flags, err)
+	}
+
+	units := utf16.Encode([]rune(input))
+	for index := 0; index <= len(units); index++ {
+		caps, err := re.MatchAt(units, index)
+		if err != nil {
+			t.Fatalf("/%s/%s: %v", pattern, flags, err)
+		}
+		if caps == nil {
+			if re.Flags.Sticky {
+				return nil
+			}
+			continue
+		}
+
+		groups := make([]string, len(caps)/2)
+		for idx := range groups {
+			if caps[2*idx] < 0 {
+				groups[idx] = "-"
+			} else {
+				groups[idx] = string(utf16.Decode(units[caps[2*idx]:caps[2*idx+1]]))
+			}
+		}
+		return groups
+	}
+	return nil
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		flags   string
+		input   string
+		want    []string
+	}{
+		// Backreferences
+		{name: "backreference", pattern: `(a+)b\1`, input: "xaabaa", want: []string{"aabaa", "aa"}},
+		{name: "backreference to later group", pattern: `\1(a)`, input: "a", want: []string{"a", "a"}},
+		{name: "backreference to unset group", pattern: `(a)?b\1`, input: "b", want: []string{"b", "-"}},
+		{name: "backreference ignore case", pattern: `(a)\1`, flags: "i", input: "aA", want: []string{"aA", "a"}},
+		{name: "named backreference", pattern: `(?<q>['"]).*?\k<q>`, input: `say "hi" now`, want: []string{`"hi"`, `"`}},
+		{name: "backreference in lookbehind", pattern: `(?<=\1(a))b`, input: "aab", want: []string{"b", "a"}},
+
+		// Lookaround
+		{name: "lookahead", pattern: `a(?=b)`, input: "acab", want: []string{"a"}},
+		{name: "negative lookahead", pattern: `a(?!b)`, input: "abac", want: []string{"a"}},
+		{name: "lookahead captures", pattern: `(?=(a+))a*b\1`, input: "baaabac", want: []string{"aba", "a"}},
+		{name: "negative lookahead resets captures", pattern: `(?!(a)b)a(.)`, input: "abac", want: []string{"ac", "-", "c"}},
+		{name: "lookbehind", pattern: `(?<=\$)\d+`, input: "cost: $42", want: []string{"42"}},
+		{name: "negative lookbehind", pattern: `(?<!\$)\b\d+`, input: "$4 and 2", want: []string{"2"}},
+		{name: "lookbehind matches backwards", pattern: `(?<=(\d+)(\d+))$`, input: "1053", want: []string{"", "1", "053"}},
+		{name: "lookbehind greedy from the right", pattern: `(?<=(\w+))c`, input: "abc", want: []string{"c", "ab"}},
+
+		// Quantifiers and empty loops
+		{name: "empty loop stops", pattern: `(a*)*`, input: "b", want: []string{"", "-"}},
+		{name: "empty loop after progress", pattern: `(a*)+`, input: "aab", want: []string{"aa", "aa"}},
+		{name: "empty alternative in loop", pattern: `(?:a|)*b`, input: "aab", want: []string{"aab"}},
+		{name: "group reset per iteration", pattern: `(z)((a+)?(b+)?(c))*`, input: "zaacbbbcac", want: []string{"zaacbbbcac", "z", "ac", "a", "-", "c"}},
+		{name: "lazy", pattern: `a+?`, input: "aaa", want: []string{"a"}},
+		{name: "bounded", pattern: `a{2,3}`, input: "aaaa", want: []string{"aaa"}},
+		{name: "bounded lazy", pattern: `a{2,3}?`, input: "aaaa", want: []string{"aa"}},
+
+		// Flags
+		{name: "ignore case", pattern: `abc`, flags: "i", input: "xABC", want: []string{"ABC"}},
+		{name: "ignore case unicode", pattern: `K`, flags: "iu", input: "k", want: []string{"k"}},
+		{name: "ignore case without unicode", pattern: `K`, flags: "i", input: "k"},
+		{name: "multiline", pattern: `^b$`, flags: "m", input: "a\nb\nc", want: []string{"b"}},
+		{name: "without multiline", pattern: `^b$`, input: "a\nb\nc"},
+		{name: "dot without dotAll", pattern: `a.b`, input: "a\nb"},
+		{name: "dotAll", pattern: `a.b`, flags: "s", input: "a\nb", want: []string{"a\nb"}},
+		{name: "sticky", pattern: `b`, flags: "y", input: "ab"},
+		{name: "sticky at start", pattern: `a`, flags: "y", input: "ab", want: []string{"a"}},
+		{name: "global", pattern: `b`, flags: "g", input: "ab", want: []string{"b"}},
+		{name: "indices", pattern: `(b)`, flags: "d", input: "ab", want: []string{"b", "b"}},
+		{name: "unicode dot", pattern: `^.$`, flags: "u", input: "😀", want: []string{"😀"}},
+		{name: "dot without unicode", pattern: `^.$`, input: "😀"},
+		{name: "unicode escape", pattern: `\u{1F600}`, flags: "u", input: "😀", want: []string{"😀"}},
+		{name: "unicode sets intersection", pattern: `[\w&&\d]+`, flags: "v", input: "ab12", want: []string{"12"}},
+		{name: "unicode sets subtraction", pattern: `[\w--\d]+`, flags: "v", input: "12ab", want: []string{"ab"}},
+		{name: "unicode sets string literal", pattern: `[\q{abc|d}]`, flags: "v", input: "xabc", want: []string{"abc"}},
+
+		// Named groups
+		{name: "named groups", pattern: `(?<year>\d{4})-(?<month>\d{2})`, input: "on 2024-05", want: []string{"2024-05", "2024", "05"}},
+		{name: "named group unset", pattern: `(?<a>a)?(?<b>b)`, input: "b", want: []string{"b", "-", "b"}},
+		{name: "named backreference with unicode", pattern: `(?<c>.)\k<c>`, flags: "u", input: "a😀😀", want: []string{"😀😀", "😀"}},
+
+		// Unicode properties
+		{name: "general category", pattern: `\p{Lu}+`, flags: "u", input: "abCDe", want: []string{"CD"}},
+		{name: "negated property", pattern: `\P{L}+`, flags: "u", input: "ab12c", want: []string{"12"}},
+		{name: "script", pattern: `\p{Script=Greek}+`, flags: "u", input: "abαβγ", want: []string{"αβγ"}},
+		{name: "general category value", pattern: `\p{gc=Nd}+`, flags: "u", input: "x٣4", want: []string{"٣4"}},
+		{name: "binary property", pattern: `\p{White_Space}+`, flags: "u", input: "a\u00a0 b", want: []string{"\u00a0 "}},
+		{name: "property outside the basic plane", pattern: `\p{L}`, flags: "u", input: "1𝒜", want: []string{"𝒜"}},
+		{name: "property ignore case", pattern: `\p{Lu}`, flags: "iu", input: "1a", want: []string{"a"}},
+		{name: "property in unicode sets", pattern: `[\p{L}--[a-z]]+`, flags: "v", input: "abCD", want: []string{"CD"}},
+		{name: "binary property alias", pattern: `\p{AHex}+`, flags: "u", input: "xyzBEEFg", want: []string{"BEEF"}},
+		{name: "script alias", pattern: `\p{sc=Grek}+`, flags: "u", input: "abαβγ", want: []string{"αβγ"}},
+		{name: "script without extensions", pattern: `\p{sc=Grek}`, flags: "u", input: "a\u0342"},
+		{name: "script extensions", pattern: `\p{scx=Grek}`, flags: "u", input: "a\u0342", want: []string{"\u0342"}},
+		{name: "script extensions replace the script", pattern: `\p{scx=Zinh}`, flags: "u", input: "\u0342"},
+		{name: "unknown script", pattern: `\p{sc=Zzzz}`, flags: "u", input: "a\U000E0080", want: []string{"\U000E0080"}},
+		{name: "emoji", pattern: `\p{Emoji_Presentation}`, flags: "u", input: "a©😀", want: []string{"😀"}},
+		{name: "extended pictographic", pattern: `\p{ExtPict}`, flags: "u", input: "a©😀", want: []string{"©"}},
+		{name: "emoji modifier", pattern: `\p{EBase}\p{EMod}`, flags: "u", input: "a👍🏽", want: []string{"👍🏽"}},
+		{name: "bidi mirrored", pattern: `\p{Bidi_M}+`, flags: "u", input: "a(<b", want: []string{"(<"}},
+		{name: "changes when uppercased", pattern: `\p{CWU}`, flags: "u", input: "ABß", want: []string{"ß"}},
+		{name: "changes when NFKC casefolded", pattern: `\p{CWKCF}+`, flags: "u", input: "a\u00AD\uFB01", want: []string{"\u00AD\uFB01"}},
+		{name: "case ignorable", pattern: `\p{CI}+`, flags: "u", input: "ab'.c", want: []string{"'."}},
+		{name: "default ignorable", pattern: `\p{DI}`, flags: "u", input: "a\u00ADb", want: []string{"\u00AD"}},
+		{name: "grapheme extend", pattern: `\p{Gr_Ext}`, flags: "u", input: "e\u0301", want: []string{"\u0301"}},
+		{name: "grapheme base", pattern: `\p{Gr_Base}+`, flags: "u", input: "\u0301ab\u0301", want: []string{"ab"}},
+		{name: "ID_Start", pattern: `\p{ID_Start}+`, flags: "u", input: "$_a\u037A", want: []string{"a\u037A"}},
+		{name: "XID_Start", pattern: `\p{XIDS}+`, flags: "u", input: "$_a\u037A", want: []string{"a"}},
+		{name: "XID_Continue", pattern: `\p{XIDC}+`, flags: "u", input: "$_a1\u037A", want: []string{"_a1"}},
+		{name: "RGI emoji", pattern: `^\p{RGI_Emoji}$`, flags: "v", input: "👨‍👩‍👧", want: []string{"👨‍👩‍👧"}},
+		{name: "RGI emoji longest first", pattern: `\p{RGI_Emoji}`, flags: "v", input: "a👍🏽", want: []string{"👍🏽"}},
+		{name: "basic emoji", pattern: `\p{Basic_Emoji}+`, flags: "v", input: "a😀©\uFE0F", want: []string{"😀©\uFE0F"}},
+		{name: "emoji flag sequence", pattern: `\p{RGI_Emoji_Flag_Sequence}`, flags: "v", input: "x🇫🇷", want: []string{"🇫🇷"}},
+		{name: "emoji keycap sequence", pattern: `\p{Emoji_Keycap_Sequence}`, flags: "v", input: "1 1\uFE0F\u20E3", want: []string{"1\uFE0F\u20E3"}},
+		{name: "property of strings in subtraction", pattern: `[\p{RGI_Emoji}--\q{😀}]`, flags: "v", input: "😀😁", want: []string{"😁"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exec(t, tt.pattern, tt.flags, tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("/%s/%s on %q: got %q, want %q", tt.pattern, tt.flags, tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+// Long inputs do not grow the goroutine stack: loops of a single character
+// keep one choice point, and other loops fail with ErrStackOverflow once they
+// keep too many.
+func TestLongInput(t *testing.T) {
+	long := make([]uint16, 1<<20)
+	for idx := range long {
+		long[idx] = 'a'
+	}
+
+	tests := []struct {
+		pattern string
+		length  int
+		end     int
+		err     error
+	}{
+		{pattern: `a*`, length: 1 << 20, end: 1 << 20},
+		{pattern: `.*?$`, length: 1 << 20, end: 1 << 20},
+		{pattern: `[ab]+?b|a+$`, length: 1 << 20, end: 1 << 20},
+		{pattern: `(?<=^a*)`, length: 1 << 20, end: 0},
+		{pattern: `(a)*`, length: 1 << 16, end: 1 << 16},
+		{pattern: `(?:a|b)*`, length: 1 << 16, end: 1 << 16},
+		{pattern: `(a)*`, length: 1 << 20, err: ErrStackOverflow},
+	}
+
+	for _, tt := range tests {
+		re, err := Compile(tt.pattern, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		caps, err := re.MatchAt(long[:tt.length], 0)
+		if err != tt.err {
+			t.Errorf("/%s/: got error %v, want %v", tt.pattern, err, tt.err)
+		} else if err == nil && (caps == nil || caps[1] != tt.end) {
+			t.Errorf("/%s/: got %v, want a match to %d", tt.pattern, caps, tt.end)
+		}
+	}
+}
+
+func TestGroupNames(t *testing.T) {
+	re, err := Compile(`(?<a>x)(y)(?<b>z)`, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"", "a", "", "b"}; !reflect.DeepEqual(re.GroupNames(), want) {
+		t.Errorf("got %q, want %q", re.GroupNames(), want)
+	}
+	if !re.HasNamedGroups() || re.GroupCount() != 3 {
+		t.Errorf("got %v named groups and a count of %d", re.HasNamedGroups(), re.GroupCount())
+	}
+}
+
+func TestFlags(t *testing.T) {
+	tests := []struct {
+		flags string
+		want  string
+		err   bool
+	}{
+		{flags: "", want: ""},
+		{flags: "ygmi", want: "gimy"},
+		{flags: "dgimsuy", want: "dgimsuy"},
+		{flags: "dgimsvy", want: "dgimsvy"},
+		{flags: "uv", err: true},
+		{flags: "gg", err: true},
+		{flags: "x", err: true},
+	}
+
+	for _, tt := range tests {
+		f, err := ParseFlags(tt.flags)
+		if tt.err {
+			if err == nil {
+				t.Errorf("%q: got flags %q, want an error", tt.flags, f.String())
+			}
+			continue
+		}
+		if err != nil || f.String() != tt.want {
+			t.Errorf("%q: got %q, %v, want %q", tt.flags, f.String(), err, tt.want)
+		}
+	}
+}
+
+func TestSyntaxError(t *testing.T) {
+	tests := []struct {
+		pattern string
+		flags   string
+	}{
+		{pattern: `a**`},
+		{pattern: `(`},
+		{pattern: `[b-a]`},
+		{pattern: `(?<n>a)(?<n>b)`},
+		{pattern: `\k<n>`, flags: "u"},
+		{pattern: `(?<n>a)\k<m>`},
+		{pattern: `\p{Unknown}`, flags: "u"},
+		{pattern: `\p{ahex}`, flags: "u"},
+		{pattern: `\p{Hyphen}`, flags: "u"},
+		{pattern: `\p{Other_Alphabetic}`, flags: "u"},
+		{pattern: `\p{sc=Greek_}`, flags: "u"},
+		{pattern: `\p{scx=L}`, flags: "u"},
+		{pattern: `\p{AHex=Y}`, flags: "u"},
+		{pattern: `\p{RGI_Emoji}`, flags: "u"},
+		{pattern: `\P{RGI_Emoji}`, flags: "v"},
+		{pattern: `[^\p{Emoji_Keycap_Sequence}]`, flags: "v"},
+		{pattern: `\1`, flags: "u"},
+		{pattern: `a{2,1}`},
+		{pattern: `(?<=a)+`, flags: "u"},
+		{pattern: `[\q{abc}]`, flags: "u"},
+		{pattern: `[a&&&b]`, flags: "v"},
+	}
+
+	for _, tt := range tests {
+		if _, err := Compile(tt.pattern, tt.flags); err == nil {
+			t.Errorf("/%s/%s compiled, want a syntax error", tt.pattern, tt.flags)
+		}
+	}
+}
@@ -0,0 +1,407 @@
+// Literals
+// https://tc39.es/ecma262/#sec-literals-numeric-literals
+// https://tc39.es/ecma262/#sec-literals-string-literals
+
+package tkn
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func isDecimalDigit(ch rune) bool {
+	return ch >= '0' && ch <= '9'
+}
+
+func isDigit(ch rune, radix int) bool {
+	switch {
+	case ch >= '0' && ch <= '9':
+		return int(ch-'0') < radix
+	case ch >= 'a' && ch <= 'f':
+		return radix == 16
+	case ch >= 'A' && ch <= 'F':
+		return radix == 16
+	default:
+		return false
+	}
+}
+
+// https://tc39.es/ecma262/#prod-IdentifierStartChar
+func isIdentifierStart(ch rune) bool {
+	if ch < utf8.RuneSelf {
+		return ch == '$' || ch == '_' || (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z')
+	}
+	return isIDStart(ch)
+}
+
+// https://tc39.es/ecma262/#prod-IdentifierPartChar
+func isIdentifierPart(ch rune) bool {
+	if ch < utf8.RuneSelf {
+		return isIdentifierStart(ch) || isDecimalDigit(ch)
+	}
+	return ch == '\u200C' || ch == '\u200D' || isIDStart(ch) ||
+		unicode.In(ch, unicode.Mn, unicode.Mc, unicode.Nd, unicode.Pc, unicode.Other_ID_Continue) && !isPatternCharacter(ch)
+}
+
+// isIDStart reports whether a code point has the Unicode ID_Start property.
+// https://unicode.org/reports/tr31/#Default_Identifier_Syntax
+func isIDStart(ch rune) bool {
+	return unicode.In(ch, unicode.L, unicode.Nl, unicode.Other_ID_Start) && !isPatternCharacter(ch)
+}
+
+func isPatternCharacter(ch rune) bool {
+	return unicode.In(ch, unicode.Pattern_Syntax, unicode.Pattern_White_Space)
+}
+
+// https://tc39.es/ecma262/#prod-NumericLiteral
+func (t *Tokenizer) resolveNumericLiteral(start rune) Token {
+	offset := t.current - 1
+
+	if start == '0' {
+		radix := 0
+		switch t.peek() {
+		case 'x', 'X':
+			radix = 16
+		case 'o', 'O':
+			radix = 8
+		case 'b', 'B':
+			radix = 2
+		}
+
+		if radix != 0 {
+			t.consume()
+			t.consumeDigits(radix, false)
+			return t.finishBigIntLiteral(offset)
+		}
+
+		// https://tc39.es/ecma262/#prod-LegacyOctalLikeDecimalIntegerLiteral
+		if isDecimalDigit(t.peek()) {
+			for isDecimalDigit(t.peek()) {
+				t.consume()
+			}
+			token := t.finishNumericLiteral(offset)
+			token.LegacyOctal = true
+			return token
+		}
+	}
+
+	if start != '.' {
+		t.consumeDigits(10, true)
+		if t.peek() == 'n' {
+			return t.finishBigIntLiteral(offset)
+		}
+		if t.peek() == '.' {
+			t.consume()
+		}
+	}
+
+	if isDecimalDigit(t.peek()) {
+		t.consumeDigits(10, false)
+	}
+
+	if t.peek() == 'e' || t.peek() == 'E' {
+		t.consume()
+		if t.peek() == '+' || t.peek() == '-' {
+			t.consume()
+		}
+		t.consumeDigits(10, false)
+	}
+
+	return t.finishNumericLiteral(offset)
+}
+
+// consumeDigits consumes digits of the radix separated by single numeric
+// separators. When started is set, a digit has already been consumed.
+// https://tc39.es/ecma262/#prod-NumericLiteralSeparator
+func (t *Tokenizer) consumeDigits(radix int, started bool) {
+	if !started && !isDigit(t.peek(), radix) {
+		panic("invalid numeric literal: missing digits")
+	}
+
+	for isDigit(t.peek(), radix) || t.peek() == '_' {
+		if t.consume() == '_' && !isDigit(t.peek(), radix) {
+			panic("invalid numeric literal: misplaced numeric separator")
+		}
+	}
+}
+
+// finishBigIntLiteral finishes an integer literal, which is a BigInt literal
+// when it is followed by the BigInt suffix.
+// https://tc39.es/ecma262/#prod-BigIntLiteralSuffix
+func (t *Tokenizer) finishBigIntLiteral(offset int) Token {
+	if t.peek() != 'n' {
+		return t.finishNumericLiteral(offset)
+	}
+
+	t.consume()
+	token := t.finishNumericLiteral(offset)
+	token.Kind = TokenKindBigIntLiteral
+	return token
+}
+
+// https://tc39.es/ecma262/#sec-literals-numeric-literals
+// The SourceCharacter immediately following a NumericLiteral must not be an
+// IdentifierStart or DecimalDigit.
+func (t *Tokenizer) finishNumericLiteral(offset int) Token {
+	if next := t.peek(); isIdentifierStart(next) || isDecimalDigit(next) || next == '\\' {
+		panic("invalid numeric literal: identifier starts immediately after numeric literal")
+	}
+
+	return NewTokenWithValue(TokenKindNumericLiteral, t.line, t.column, string(t.text[offset:t.current]))
+}
+
+// NumericValue returns the value of the source text of a NumericLiteral.
+// https://tc39.es/ecma262/#sec-numericvalue
+func NumericValue(literal string) float64 {
+	literal = strings.Replace(literal, "_", "", -1)
+
+	if len(literal) > 1 && literal[0] == '0' {
+		switch literal[1] {
+		case 'x', 'X':
+			return parseInteger(literal[2:], 16)
+		case 'o', 'O':
+			return parseInteger(literal[2:], 8)
+		case 'b', 'B':
+			return parseInteger(literal[2:], 2)
+		}
+
+		if strings.IndexFunc(literal, func(r rune) bool { return !isDigit(r, 8) }) < 0 {
+			return parseInteger(literal[1:], 8)
+		}
+	}
+
+	value, _ := strconv.ParseFloat(literal, 64)
+	return value
+}
+
+// BigIntValue returns the value of the source text of a BigInt literal,
+// including its suffix.
+// https://tc39.es/ecma262/#sec-static-semantics-bigintvalue
+func BigIntValue(literal string) *big.Int {
+	literal = strings.Replace(strings.TrimSuffix(literal, "n"), "_", "", -1)
+
+	radix := 10
+	if len(literal) > 1 && literal[0] == '0' {
+		switch literal[1] {
+		case 'x', 'X':
+			radix = 16
+		case 'o', 'O':
+			radix = 8
+		case 'b', 'B':
+			radix = 2
+		}
+		if radix != 10 {
+			literal = literal[2:]
+		}
+	}
+
+	value, _ := new(big.Int).SetString(literal, radix)
+	return value
+}
+
+func parseInteger(digits string, radix int) float64 {
+	f, _, _ := big.ParseFloat(digits, radix, 53, big.ToNearestEven)
+	value, _ := f.Float64()
+	return value
+}
+
+// https://tc39.es/ecma262/#prod-StringLiteral
+func (t *Tokenizer) resolveStringLiteral(quote rune) Token {
+	var value strings.Builder
+	t.legacyOctal = false
+	for {
+		ch := t.consume()
+		switch {
+		case ch == quote:
+			token := NewTokenWithValue(TokenKindStringLiteral, t.line, t.column, value.String())
+			token.LegacyOctal = t.legacyOctal
+			return token
+		case ch == -1 || ch == '\n' || ch == '\r':
+			panic("unterminated string literal")
+		case ch == '\\':
+			t.resolveEscapeSequence(&value)
+		default:
+			value.WriteRune(ch)
+		}
+	}
+}
+
+// https://tc39.es/ecma262/#prod-EscapeSequence
+// https://tc39.es/ecma262/#prod-LineContinuation
+func (t *Tokenizer) resolveEscapeSequence(value *strings.Builder) {
+	ch := t.consume()
+	switch ch {
+	case 'b':
+		value.WriteByte('\b')
+	case 'f':
+		value.WriteByte('\f')
+	case 'n':
+		value.WriteByte('\n')
+	case 'r':
+		value.WriteByte('\r')
+	case 't':
+		value.WriteByte('\t')
+	case 'v':
+		value.WriteByte('\v')
+	case '\r':
+		if t.peek() == '\n' {
+			t.consume()
+		}
+	case '\n':
+	case 'x':
+		value.WriteRune(rune(t.consumeHexDigits(2)))
+	case 'u':
+		t.resolveUnicodeEscapeSequence(value)
+	case -1:
+		panic("unterminated string literal")
+	default:
+		// \0 not followed by a digit is the only octal escape allowed in
+		// strict mode, which forbids \8 and \9 as well.
+		// https://tc39.es/ecma262/#prod-NonOctalDecimalEscapeSequence
+		if isDecimalDigit(ch) && (ch != '0' || isDecimalDigit(t.peek())) {
+			t.legacyOctal = true
+		}
+
+		if isDigit(ch, 8) {
+			value.WriteRune(t.resolveLegacyOctalEscapeSequence(ch))
+		} else {
+			value.WriteRune(ch)
+		}
+	}
+}
+
+// https://tc39.es/ecma262/#prod-LegacyOctalEscapeSequence
+func (t *Tokenizer) resolveLegacyOctalEscapeSequence(first rune) rune {
+	code := first - '0'
+	if !isDigit(t.peek(), 8) {
+		return code
+	}
+
+	code = code*8 + t.consume() - '0'
+	if first <= '3' && isDigit(t.peek(), 8) {
+		code = code*8 + t.consume() - '0'
+	}
+	return code
+}
+
+// https://tc39.es/ecma262/#prod-UnicodeEscapeSequence
+func (t *Tokenizer) resolveUnicodeEscapeSequence(value *strings.Builder) {
+	code := t.consumeCodePoint()
+
+	// Combine an escaped surrogate pair into the code point it encodes.
+	if code >= 0xD800 && code <= 0xDBFF && t.lookingAt("\\u") {
+		mark, column := t.current, t.column
+		t.consume()
+		t.consume()
+		if trail := t.consumeCodePoint(); trail >= 0xDC00 && trail <= 0xDFFF {
+			value.WriteRune(rune((code-0xD800)<<10 + (trail - 0xDC00) + 0x10000))
+			return
+		}
+		t.current, t.column = mark, column
+	}
+
+	writeCodeUnit(value, rune(code))
+}
+
+// writeCodeUnit writes a code point to a string value. A lone surrogate is
+// written in the three-byte form WTF-8 gives it, which is how lang strings
+// hold code units that are not part of a pair.
+// https://simonsapin.github.io/wtf-8/
+func writeCodeUnit(value *strings.Builder, code rune) {
+	if code < 0xD800 || code > 0xDFFF {
+		value.WriteRune(code)
+		return
+	}
+
+	value.WriteByte(byte(0xE0 | code>>12))
+	value.WriteByte(byte(0x80 | code>>6&0x3F))
+	value.WriteByte(byte(0x80 | code&0x3F))
+}
+
+func (t *Tokenizer) consumeCodePoint() int {
+	if t.peek() != '{' {
+		return t.consumeHexDigits(4)
+	}
+
+	t.consume()
+	code := 0
+	for t.peek() != '}' {
+		if !isDigit(t.peek(), 16) {
+			panic("invalid Unicode escape sequence")
+		}
+
+		d, _ := strconv.ParseInt(string(t.consume()), 16, 32)
+		code = code*16 + int(d)
+		if code > utf8.MaxRune {
+			panic("undefined Unicode code-point")
+		}
+	}
+	t.consume()
+	return code
+}
+
+func (t *Tokenizer) consumeHexDigits(n int) int {
+	code := 0
+	for i := 0; i < n; i++ {
+		if !isDigit(t.peek(), 16) {
+			panic("invalid hexadecimal escape sequence")
+		}
+
+		d, _ := strconv.ParseInt(string(t.consume()), 16, 32)
+		code = code*16 + int(d)
+	}
+	return code
+}
+
+// regularExpressionAllowed reports whether a slash starts a regular expression
+// literal rather than a division. The grammar allows a regular expression
+// wherever an expression may start, which is judged from the previous token.
+// https://tc39.es/ecma262/#sec-ecmascript-language-lexical-grammar
+func (t *Tokenizer) regularExpressionAllowed() bool {
+	switch t.previous {
+	case TokenKindIdentifier, TokenKindNumericLiteral, TokenKindBigIntLiteral, TokenKindStringLiteral,
+		TokenKindRegularExpressionLiteral, TokenKindNoSubstitutionTemplate, TokenKindTemplateTail,
+		TokenKindRightSquareBracket, TokenKindPlusPlus, TokenKindMinusMinus, TokenKindThis, TokenKindSuper,
+		TokenKindPrivateIdentifier, TokenKindTrue, TokenKindFalse, TokenKindNull:
+		return false
+	case TokenKindRightParen:
+		return t.controlParen
+	default:
+		return true
+	}
+}
+
+// resolveRegularExpressionLiteral scans a regular expression literal after
+// its opening slash. The token value holds the whole literal, including the
+// slashes and the flags; the pattern is validated by the parser.
+// https://tc39.es/ecma262/#prod-RegularExpressionLiteral
+func (t *Tokenizer) resolveRegularExpressionLiteral() Token {
+	offset := t.current - 1
+
+	class := false
+	for done := false; !done; {
+		ch := t.consume()
+		switch {
+		case ch == -1 || isLineTerminator(ch):
+			panic("unterminated regular expression literal")
+		case ch == '\\':
+			if next := t.consume(); next == -1 || isLineTerminator(next) {
+				panic("unterminated regular expression literal")
+			}
+		case ch == '[':
+			class = true
+		case ch == ']':
+			class = false
+		case ch == '/' && !class:
+			done = true
+		}
+	}
+
+	for isIdentifierPart(t.peek()) {
+		t.consume()
+	}
+	return NewTokenWithValue(TokenKindRegularExpressionLiteral, t.line, t.column, string(t.text[offset:t.current]))
+}
@@ -33,9 +33,6 @@ var punctuator = map[rune]Punctuator{
 			{
 				value: '.',
 				token: TokenKindQuestionPeriod,
-				//
-				// TODO (ctfloyd): this should be illegal if the next digit is a decimal digit.
-				// 				   see: https://tc39.es/ecma262/#prod-OptionalChainingPunctuator
 			},
 			{
 				value: '?',
@@ -0,0 +1,240 @@
+package tkn
+
+import (
+	"fmt"
+	"io"
+)
+
+// Goal is the lexical goal symbol a token is scanned with. The syntactic
+// grammar decides which one applies; the tokenizer guesses it from the tokens
+// before, and a parser rescans a token when the guess was wrong.
+// https://tc39.es/ecma262/#sec-ecmascript-language-lexical-grammar
+type Goal int
+
+const (
+	// GoalDiv scans a slash as a division and a closing brace as a
+	// punctuator.
+	GoalDiv Goal = iota
+
+	// GoalRegExp scans a slash as the start of a regular expression literal.
+	GoalRegExp
+
+	// GoalRegExpOrTemplateTail scans a slash as the start of a regular
+	// expression literal and a closing brace as the end of a template
+	// substitution.
+	GoalRegExpOrTemplateTail
+
+	// GoalTemplateTail scans a closing brace as the end of a template
+	// substitution.
+	GoalTemplateTail
+)
+
+func (g Goal) regExp() bool {
+	return g == GoalRegExp || g == GoalRegExpOrTemplateTail
+}
+
+func (g Goal) templateTail() bool {
+	return g == GoalTemplateTail || g == GoalRegExpOrTemplateTail
+}
+
+// readSize is the number of bytes read from the reader at a time.
+const readSize = 4096
+
+// NewTokenizer returns a tokenizer that reads the source text from r as the
+// tokens are scanned. It holds on to the text of the last two tokens only,
+// and the text retained with Retain.
+func NewTokenizer(r io.Reader, options ...Option) *Tokenizer {
+	t := &Tokenizer{reader: r}
+	for _, option := range options {
+		option(t)
+	}
+	return t
+}
+
+// NewTokenizerBytes returns a tokenizer over source text in memory.
+func NewTokenizerBytes(text []byte, options ...Option) *Tokenizer {
+	t := &Tokenizer{text: text, eof: true}
+	for _, option := range options {
+		option(t)
+	}
+	return t
+}
+
+// SyntaxError is an error in the source text, at a byte offset and the
+// location of that offset.
+type SyntaxError struct {
+	Offset   int
+	Location Location
+	Message  string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s (line %d, column %d)", e.Message, e.Location.Line, e.Location.Column)
+}
+
+// readError carries an error of the reader out of the scanner.
+type readError struct {
+	err error
+}
+
+// scanState is the state of the tokenizer before a token, from which the
+// token can be scanned again.
+type scanState struct {
+	valid        bool
+	offset       int
+	newline      bool
+	leading      []Trivia
+	line, column int
+	previous     TokenKind
+	braces       []bool
+	parens       []bool
+	controlParen bool
+}
+
+// Next scans the next token. Once the source text is exhausted it returns an
+// EOF token every time.
+func (t *Tokenizer) Next() (token Token, err error) {
+	defer t.recover(&err)
+
+	t.discard()
+	return t.next(t.goal()), nil
+}
+
+// Rescan scans a token again with another goal, as the parser expects a
+// regular expression literal or the rest of a template where the tokenizer
+// found a division or a closing brace. Only the last two tokens returned can
+// be rescanned; the token after the rescanned one is scanned again by the next
+// call to Next.
+func (t *Tokenizer) Rescan(token Token, goal Goal) (result Token, err error) {
+	defer t.recover(&err)
+
+	idx := -1
+	for i, state := range t.saved {
+		if state.valid && state.offset == token.Start {
+			idx = i
+		}
+	}
+	if idx < 0 {
+		return Token{}, &SyntaxError{Offset: token.Start, Location: token.Location, Message: "token cannot be rescanned"}
+	}
+
+	state := t.saved[idx]
+	if idx == 0 {
+		t.saved[0], t.saved[1] = scanState{}, state
+	}
+	t.restore(state)
+	return t.scan(state.newline, state.leading, goal), nil
+}
+
+// goal guesses the goal of the next token from the tokens before it.
+func (t *Tokenizer) goal() Goal {
+	regExp := t.regularExpressionAllowed()
+	template := len(t.braces) > 0 && t.braces[len(t.braces)-1]
+	switch {
+	case regExp && template:
+		return GoalRegExpOrTemplateTail
+	case regExp:
+		return GoalRegExp
+	case template:
+		return GoalTemplateTail
+	default:
+		return GoalDiv
+	}
+}
+
+func (t *Tokenizer) save(newline bool, leading []Trivia) scanState {
+	return scanState{
+		valid:        true,
+		offset:       t.base + t.current,
+		newline:      newline,
+		leading:      leading,
+		line:         t.line,
+		column:       t.column,
+		previous:     t.previous,
+		braces:       append([]bool(nil), t.braces...),
+		parens:       append([]bool(nil), t.parens...),
+		controlParen: t.controlParen,
+	}
+}
+
+func (t *Tokenizer) restore(state scanState) {
+	t.current = state.offset - t.base
+	t.line, t.column = state.line, state.column
+	t.previous = state.previous
+	t.braces = append(t.braces[:0], state.braces...)
+	t.parens = append(t.parens[:0], state.parens...)
+	t.controlParen = state.controlParen
+}
+
+// Retain keeps the source text from the offset on until the matching call to
+// Release, so that Text can return the text of the tokens after it. The offset
+// must be the start of one of the last two tokens.
+func (t *Tokenizer) Retain(offset int) {
+	t.retained = append(t.retained, offset)
+}
+
+// Release stops keeping the source text from the offset of the last call to
+// Retain.
+func (t *Tokenizer) Release() {
+	t.retained = t.retained[:len(t.retained)-1]
+}
+
+// Text returns the source text between the byte offsets, which must be
+// retained.
+func (t *Tokenizer) Text(start, end int) string {
+	return string(t.text[start-t.base : end-t.base])
+}
+
+// discard drops the source text before the last token, which cannot be
+// rescanned once another token is scanned, unless it is retained.
+func (t *Tokenizer) discard() {
+	if !t.saved[1].valid {
+		return
+	}
+
+	n := t.saved[1].offset - t.base
+	if len(t.retained) > 0 && t.retained[0]-t.base < n {
+		n = t.retained[0] - t.base
+	}
+	t.text = t.text[n:]
+	t.current -= n
+	t.base += n
+}
+
+// fill reads from the reader until n bytes follow the current position or
+// the reader is exhausted.
+func (t *Tokenizer) fill(n int) {
+	for !t.eof && len(t.text)-t.current < n {
+		if t.reader == nil {
+			t.eof = true
+			return
+		}
+
+		if len(t.text) == cap(t.text) {
+			text := make([]byte, len(t.text), 2*len(t.text)+readSize)
+			copy(text, t.text)
+			t.text = text
+		}
+
+		m, err := t.reader.Read(t.text[len(t.text):cap(t.text)])
+		t.text = t.text[:len(t.text)+m]
+		if err == io.EOF {
+			t.eof = true
+		} else if err != nil {
+			panic(readError{err})
+		}
+	}
+}
+
+// recover turns a panic of the scanner into the error of Next or Rescan.
+func (t *Tokenizer) recover(err *error) {
+	switch r := recover().(type) {
+	case nil:
+	case string:
+		*err = &SyntaxError{Offset: t.base + t.current, Location: Location{Line: t.line + 1, Column: t.column + 1}, Message: r}
+	case readError:
+		*err = r.err
+	default:
+		panic(r)
+	}
+}
@@ -0,0 +1,111 @@
+package tkn
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestRescan(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		// after is the number of tokens scanned before the rescan, of which
+		// the one at index is scanned again with the goal.
+		after, index int
+		goal         Goal
+		want         []string
+	}{
+		{
+			name:  "division as regular expression",
+			src:   "a / b /g.c",
+			after: 2, index: 1, goal: GoalRegExp,
+			want: []string{"a", "/ b /g", ".", "c", ""},
+		},
+		{
+			name:  "token before last",
+			src:   "a / b /g",
+			after: 3, index: 1, goal: GoalRegExp,
+			want: []string{"a", "/ b /g", ""},
+		},
+		{
+			name:  "regular expression as division",
+			src:   "x = {} / 2 / 1",
+			after: 5, index: 4, goal: GoalDiv,
+			want: []string{"x", "=", "{", "}", "/", "2", "/", "1", ""},
+		},
+		{
+			name:  "unterminated regular expression guessed",
+			src:   "{} / 2\n",
+			after: -1,
+			want:  []string{"{", "}", "/", "2", ""},
+		},
+		{
+			name:  "brace as template tail",
+			src:   "{}`",
+			after: 2, index: 1, goal: GoalTemplateTail,
+			want: []string{"{", "}`", ""},
+		},
+		{
+			name:  "regular expression with a newline before",
+			src:   "a\n/=b/",
+			after: 2, index: 1, goal: GoalRegExp,
+			want: []string{"a", "/=b/", ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokenizers := map[string]*Tokenizer{
+				"bytes":  NewTokenizerBytes([]byte(tt.src)),
+				"stream": NewTokenizer(iotest.OneByteReader(strings.NewReader(tt.src))),
+			}
+			for name, tokenizer := range tokenizers {
+				var tokens []Token
+				rescanned := false
+				for len(tokens) == 0 || tokens[len(tokens)-1].Kind != TokenKindEOF {
+					if len(tokens) == tt.after && !rescanned {
+						rescanned = true
+						token, err := tokenizer.Rescan(tokens[tt.index], tt.goal)
+						if err != nil {
+							t.Fatalf("%s: %v", name, err)
+						}
+						tokens = append(tokens[:tt.index], token)
+						continue
+					}
+
+					token, err := tokenizer.Next()
+					if err != nil {
+						t.Fatalf("%s: %v", name, err)
+					}
+					tokens = append(tokens, token)
+				}
+
+				var got []string
+				for _, token := range tokens {
+					got = append(got, token.Raw)
+				}
+				if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+					t.Errorf("%s: got %q, want %q", name, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRescanOldToken(t *testing.T) {
+	tokenizer := NewTokenizerBytes([]byte("a / b / c / d"))
+	var tokens []Token
+	for idx := 0; idx < 4; idx++ {
+		token, err := tokenizer.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		tokens = append(tokens, token)
+	}
+
+	_, err := tokenizer.Rescan(tokens[1], GoalRegExp)
+	if err == nil || !strings.Contains(err.Error(), "token cannot be rescanned") {
+		t.Errorf("got %v, want an error", err)
+	}
+}
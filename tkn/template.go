@@ -0,0 +1,96 @@
+// Template Literal Lexical Components
+// https://tc39.es/ecma262/#sec-template-literal-lexical-components
+
+package tkn
+
+import (
+	"strings"
+)
+
+// resolveTemplate scans a template span up to and including the closing
+// backtick or the `${` opening a substitution. The token value holds the raw
+// characters of the span, with line terminator sequences normalized to <LF>.
+// https://tc39.es/ecma262/#prod-Template
+func (t *Tokenizer) resolveTemplate(head bool) Token {
+	var raw strings.Builder
+	for {
+		ch := t.consume()
+		switch ch {
+		case -1:
+			panic("unterminated template literal")
+		case '`':
+			kind := TokenKindTemplateTail
+			if head {
+				kind = TokenKindNoSubstitutionTemplate
+			}
+			return NewTokenWithValue(kind, t.line, t.column, raw.String())
+		case '$':
+			if t.peek() != '{' {
+				raw.WriteByte('$')
+				continue
+			}
+
+			t.consume()
+			t.braces = append(t.braces, true)
+
+			kind := TokenKindTemplateMiddle
+			if head {
+				kind = TokenKindTemplateHead
+			}
+			return NewTokenWithValue(kind, t.line, t.column, raw.String())
+		case '\\':
+			raw.WriteByte('\\')
+			escaped := t.consume()
+			if escaped == -1 {
+				panic("unterminated template literal")
+			}
+			t.writeTemplateCharacter(&raw, escaped)
+		default:
+			t.writeTemplateCharacter(&raw, ch)
+		}
+	}
+}
+
+// https://tc39.es/ecma262/#sec-static-semantics-trv
+func (t *Tokenizer) writeTemplateCharacter(raw *strings.Builder, ch rune) {
+	if ch == '\r' {
+		if t.peek() == '\n' {
+			t.consume()
+		}
+		ch = '\n'
+	}
+	raw.WriteRune(ch)
+}
+
+// CookTemplate returns the template value of the raw characters of a template
+// span. It reports false when the span contains an escape sequence that is
+// not allowed in templates, in which case a tagged template receives
+// undefined as the cooked string.
+// https://tc39.es/ecma262/#sec-static-semantics-tv
+func CookTemplate(raw string) (cooked string, ok bool) {
+	defer func() {
+		if recover() != nil {
+			cooked, ok = "", false
+		}
+	}()
+
+	t := NewTokenizerBytes([]byte(raw))
+	var value strings.Builder
+	for t.current < len(raw) {
+		ch := t.consume()
+		if ch != '\\' {
+			value.WriteRune(ch)
+			continue
+		}
+
+		// https://tc39.es/ecma262/#prod-NotEscapeSequence
+		// Only \0 not followed by a digit remains of the octal escapes.
+		if next := t.peek(); isDecimalDigit(next) {
+			if next != '0' || (t.current+1 < len(raw) && isDecimalDigit(rune(raw[t.current+1]))) {
+				return "", false
+			}
+		}
+		t.resolveEscapeSequence(&value)
+	}
+	return value.String(), true
+}
@@ -1,8 +1,11 @@
 package tkn
 
 import (
-	"strconv"
+	"fmt"
+	"io"
+	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 type TokenKind int
@@ -17,16 +20,33 @@ const (
 	TokenKindAsteriskAsterisk
 	TokenKindAsteriskAsteriskEqual
 	TokenKindAsteriskEqual
+	TokenKindBigIntLiteral
+	TokenKindBreak
 	TokenKindCaret
 	TokenKindCaretEqual
+	TokenKindCase
+	TokenKindCatch
+	TokenKindClass
 	TokenKindColon
 	TokenKindComma
+	TokenKindConst
+	TokenKindContinue
+	TokenKindDebugger
+	TokenKindDefault
+	TokenKindDelete
+	TokenKindDo
 	TokenKindEOF
+	TokenKindElse
+	TokenKindEnum
 	TokenKindEqual
 	TokenKindEqualEqual
 	TokenKindEqualEqualEqual
 	TokenKindEqualGreatherThan
 	TokenKindExclamation
+	TokenKindExport
+	TokenKindExtends
+	TokenKindFalse
+	TokenKindFinally
 	TokenKindFor
 	TokenKindFunction
 	TokenKindGreaterThan
@@ -37,7 +57,9 @@ const (
 	TokenKindGreaterThanOrEqual
 	TokenKindIdentifier
 	TokenKindIf
-	TokenKindIntLiteral
+	TokenKindImport
+	TokenKindIn
+	TokenKindInstanceof
 	TokenKindLeftBrace
 	TokenKindLeftParen
 	TokenKindLeftSquareBracket
@@ -48,8 +70,12 @@ const (
 	TokenKindMinus
 	TokenKindMinusEqual
 	TokenKindMinusMinus
+	TokenKindNew
+	TokenKindNoSubstitutionTemplate
 	TokenKindNotEqual
 	TokenKindNotEqualEqual
+	TokenKindNull
+	TokenKindNumericLiteral
 	TokenKindPercent
 	TokenKindPercentEqual
 	TokenKindPeriod
@@ -60,10 +86,12 @@ const (
 	TokenKindPlus
 	TokenKindPlusEqual
 	TokenKindPlusPlus
+	TokenKindPrivateIdentifier
 	TokenKindQuestion
 	TokenKindQuestionPeriod
 	TokenKindQuestionQuestion
 	TokenKindQuestionQuestionEqual
+	TokenKindRegularExpressionLiteral
 	TokenKindReturn
 	TokenKindRightBrace
 	TokenKindRightParen
@@ -72,8 +100,22 @@ const (
 	TokenKindSlash
 	TokenKindSlashEqual
 	TokenKindSpread
+	TokenKindStringLiteral
+	TokenKindSuper
+	TokenKindSwitch
+	TokenKindTemplateHead
+	TokenKindTemplateMiddle
+	TokenKindTemplateTail
+	TokenKindThis
+	TokenKindThrow
 	TokenKindTilde
+	TokenKindTrue
+	TokenKindTry
+	TokenKindTypeof
 	TokenKindVar
+	TokenKindVoid
+	TokenKindWhile
+	TokenKindWith
 )
 
 func (tk TokenKind) String() string {
@@ -100,12 +142,38 @@ func (tk TokenKind) String() string {
 		return "Caret"
 	case TokenKindCaretEqual:
 		return "CaretEqual"
+	case TokenKindCase:
+		return "Case"
+	case TokenKindCatch:
+		return "Catch"
+	case TokenKindBigIntLiteral:
+		return "BigIntLiteral"
+	case TokenKindBreak:
+		return "Break"
+	case TokenKindClass:
+		return "Class"
 	case TokenKindColon:
 		return "Colon"
 	case TokenKindComma:
 		return "Comma"
+	case TokenKindConst:
+		return "Const"
+	case TokenKindContinue:
+		return "Continue"
+	case TokenKindDebugger:
+		return "Debugger"
+	case TokenKindDefault:
+		return "Default"
+	case TokenKindDelete:
+		return "Delete"
+	case TokenKindDo:
+		return "Do"
 	case TokenKindEOF:
 		return "EOF"
+	case TokenKindElse:
+		return "Else"
+	case TokenKindEnum:
+		return "Enum"
 	case TokenKindEqual:
 		return "Equal"
 	case TokenKindEqualEqual:
@@ -116,6 +184,14 @@ func (tk TokenKind) String() string {
 		return "EqualGreatherThan"
 	case TokenKindExclamation:
 		return "Exclamation"
+	case TokenKindExport:
+		return "Export"
+	case TokenKindExtends:
+		return "Extends"
+	case TokenKindFalse:
+		return "False"
+	case TokenKindFinally:
+		return "Finally"
 	case TokenKindFor:
 		return "For"
 	case TokenKindFunction:
@@ -136,8 +212,12 @@ func (tk TokenKind) String() string {
 		return "Identifier"
 	case TokenKindIf:
 		return "If"
-	case TokenKindIntLiteral:
-		return "IntLiteral"
+	case TokenKindImport:
+		return "Import"
+	case TokenKindIn:
+		return "In"
+	case TokenKindInstanceof:
+		return "Instanceof"
 	case TokenKindLeftBrace:
 		return "LeftBrace"
 	case TokenKindLeftParen:
@@ -158,10 +238,18 @@ func (tk TokenKind) String() string {
 		return "MinusEqual"
 	case TokenKindMinusMinus:
 		return "MinusMinus"
+	case TokenKindNew:
+		return "New"
+	case TokenKindNoSubstitutionTemplate:
+		return "NoSubstitutionTemplate"
 	case TokenKindNotEqual:
 		return "NotEqual"
 	case TokenKindNotEqualEqual:
 		return "NotEqualEqual"
+	case TokenKindNull:
+		return "Null"
+	case TokenKindNumericLiteral:
+		return "NumericLiteral"
 	case TokenKindPercent:
 		return "Percent"
 	case TokenKindPercentEqual:
@@ -182,6 +270,8 @@ func (tk TokenKind) String() string {
 		return "PlusEqual"
 	case TokenKindPlusPlus:
 		return "PlusPlus"
+	case TokenKindPrivateIdentifier:
+		return "PrivateIdentifier"
 	case TokenKindQuestion:
 		return "Question"
 	case TokenKindQuestionPeriod:
@@ -190,6 +280,8 @@ func (tk TokenKind) String() string {
 		return "QuestionQuestion"
 	case TokenKindQuestionQuestionEqual:
 		return "QuestionQuestionEqual"
+	case TokenKindRegularExpressionLiteral:
+		return "RegularExpressionLiteral"
 	case TokenKindReturn:
 		return "Return"
 	case TokenKindRightBrace:
@@ -206,15 +298,60 @@ func (tk TokenKind) String() string {
 		return "SlashEqual"
 	case TokenKindSpread:
 		return "Spread"
+	case TokenKindStringLiteral:
+		return "StringLiteral"
+	case TokenKindSuper:
+		return "Super"
+	case TokenKindSwitch:
+		return "Switch"
+	case TokenKindTemplateHead:
+		return "TemplateHead"
+	case TokenKindTemplateMiddle:
+		return "TemplateMiddle"
+	case TokenKindTemplateTail:
+		return "TemplateTail"
+	case TokenKindThis:
+		return "This"
+	case TokenKindThrow:
+		return "Throw"
 	case TokenKindTilde:
 		return "Tilde"
+	case TokenKindTrue:
+		return "True"
+	case TokenKindTry:
+		return "Try"
+	case TokenKindTypeof:
+		return "Typeof"
 	case TokenKindVar:
 		return "Var"
+	case TokenKindVoid:
+		return "Void"
+	case TokenKindWhile:
+		return "While"
+	case TokenKindWith:
+		return "With"
 	default:
 		return "Unknown"
 	}
 }
 
+// IsKeyword reports whether the kind is a reserved word, which is an
+// IdentifierName but not an Identifier.
+// https://tc39.es/ecma262/#sec-keywords-and-reserved-words
+func (tk TokenKind) IsKeyword() bool {
+	switch tk {
+	case TokenKindBreak, TokenKindCase, TokenKindCatch, TokenKindClass, TokenKindConst, TokenKindContinue,
+		TokenKindDebugger, TokenKindDefault, TokenKindDelete, TokenKindDo, TokenKindElse, TokenKindEnum,
+		TokenKindExport, TokenKindExtends, TokenKindFalse, TokenKindFinally, TokenKindFor, TokenKindFunction,
+		TokenKindIf, TokenKindImport, TokenKindIn, TokenKindInstanceof, TokenKindNew, TokenKindNull,
+		TokenKindReturn, TokenKindSuper, TokenKindSwitch, TokenKindThis, TokenKindThrow, TokenKindTrue,
+		TokenKindTry, TokenKindTypeof, TokenKindVar, TokenKindVoid, TokenKindWhile, TokenKindWith:
+		return true
+	default:
+		return false
+	}
+}
+
 // https://tc39.es/ecma262/#sec-white-space
 var whitespace = map[rune]interface{}{
 	'\u0009': nil, // Character Tabulation <TAB>
@@ -229,16 +366,47 @@ func isWhitespace(r rune) bool {
 		return true
 	}
 
-	return unicode.IsSpace(r)
+	return isLineTerminator(r) || unicode.Is(unicode.Zs, r)
+}
+
+// https://tc39.es/ecma262/#sec-line-terminators
+func isLineTerminator(r rune) bool {
+	return r == '\n' || r == '\r' || r == '\u2028' || r == '\u2029'
 }
 
+// Location is a 1-based line and column in the source text. Columns count
+// UTF-16 code units, as source maps do.
 type Location struct {
 	Line, Column int
 }
+
 type Token struct {
-	Location Location
-	Kind     TokenKind
-	Value    string
+	// Location is where the token starts, and Start and End are the byte
+	// offsets of its source text, which Raw holds.
+	Location   Location
+	Start, End int
+	Raw        string
+
+	Kind  TokenKind
+	Value string
+
+	// NewlineBefore is set when a line terminator separates the token from the
+	// previous one.
+	NewlineBefore bool
+
+	// LeadingTrivia is the trivia between the trailing trivia of the previous
+	// token and this one, and TrailingTrivia the trivia after it up to the end
+	// of its line. Both are only set by a tokenizer made WithTrivia.
+	LeadingTrivia  []Trivia
+	TrailingTrivia []Trivia
+
+	// Escaped is set for identifiers spelled with Unicode escape sequences,
+	// which are never keywords.
+	Escaped bool
+
+	// LegacyOctal is set for legacy octal numeric literals, as in 010, and for
+	// string literals with legacy octal escapes, which strict mode forbids.
+	LegacyOctal bool
 }
 
 func NewToken(kind TokenKind, line, column int) Token {
@@ -254,115 +422,352 @@ func NewTokenWithValue(kind TokenKind, line, column int, value string) Token {
 }
 
 type Tokenizer struct {
-	text    string
+	// text holds the source text from offset base on, as far as it has been
+	// read; current is the position of the scanner in it.
+	reader  io.Reader
+	text    []byte
+	base    int
 	current int
+	eof     bool
 
+	// trivia is set when tokens carry the trivia around them.
+	trivia bool
+
+	// saved holds the state before the last two tokens, which may still be
+	// scanned again with another goal.
+	saved [2]scanState
+
+	// retained holds the offsets from which the source text is kept for Text,
+	// outermost first.
+	retained []int
+
+	// braces records for every open brace whether it started a template
+	// substitution, so the closing brace resumes the template.
+	braces []bool
+
+	// previous is the kind of the last token, which decides whether a slash
+	// starts a regular expression literal. parens records for every open
+	// parenthesis whether it started the head of an if, for or while
+	// statement, and controlParen whether the last closing parenthesis ended
+	// one.
+	previous     TokenKind
+	parens       []bool
+	controlParen bool
+
+	// legacyOctal records whether the string literal being scanned contains a
+	// legacy octal escape sequence.
+	legacyOctal bool
+
+	// line and column are the 0-based position of the scanner.
 	line   int
 	column int
 }
 
+// Tokenize scans the whole source text at once.
 func (t *Tokenizer) Tokenize(text string) []Token {
-	t.text = text
+	t.text, t.eof = []byte(text), true
 
 	tokens := make([]Token, 0, 128)
+	for {
+		token, err := t.Next()
+		if err != nil {
+			panic(err.Error())
+		}
 
-	buffer := ""
-	for t.current < len(text) {
-		ch := t.consume()
+		tokens = append(tokens, token)
+		if token.Kind == TokenKindEOF {
+			return tokens
+		}
+	}
+}
 
-		if isWhitespace(ch) {
-			if token, ok := t.resolveBuffer(buffer); ok {
-				tokens = append(tokens, token)
-			}
-			buffer = ""
-		} else if isPunctuatorStart(ch) {
-			if token, ok := t.resolveBuffer(buffer); ok {
-				tokens = append(tokens, token)
-			}
-			buffer = ""
+// next scans the token that follows with the goal. A slash guessed to start a
+// regular expression literal that does not end on its line is scanned as a
+// division instead, which the parser rescans when it expects a literal.
+func (t *Tokenizer) next(goal Goal) Token {
+	leading, newline := t.scanTrivia(false)
 
-			token := t.resolvePunctuator(ch)
-			tokens = append(tokens, token)
+	t.saved[0], t.saved[1] = t.saved[1], t.save(newline, leading)
+	if goal.regExp() {
+		if token, ok := t.tryScan(newline, leading, goal); ok {
+			return token
+		}
+		t.restore(t.saved[1])
+		if goal.templateTail() {
+			goal = GoalTemplateTail
 		} else {
-			buffer += string(ch)
+			goal = GoalDiv
 		}
 	}
+	return t.scan(newline, leading, goal)
+}
 
-	if token, ok := t.resolveBuffer(buffer); ok {
-		tokens = append(tokens, token)
+// tryScan scans a token, reporting whether it is valid rather than panicking.
+func (t *Tokenizer) tryScan(newline bool, leading []Trivia, goal Goal) (token Token, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, syntax := r.(string); !syntax {
+				panic(r)
+			}
+		}
+	}()
+	return t.scan(newline, leading, goal), true
+}
+
+func (t *Tokenizer) scan(newline bool, leading []Trivia, goal Goal) Token {
+	start, line, column := t.current, t.line, t.column
+	token := t.resolveToken(goal)
+	token.Location = Location{Line: line + 1, Column: column + 1}
+	token.Start, token.End = t.base+start, t.base+t.current
+	token.Raw = string(t.text[start:t.current])
+	token.NewlineBefore = newline
+	t.previous = token.Kind
+
+	if t.trivia {
+		token.LeadingTrivia = leading
+		token.TrailingTrivia, _ = t.scanTrivia(true)
 	}
+	return token
+}
 
-	tokens = append(tokens, Token{Kind: TokenKindEOF, Location: Location{Line: t.line, Column: t.column}})
-	return tokens
+// https://tc39.es/ecma262/#sec-ecmascript-language-lexical-grammar
+func (t *Tokenizer) resolveToken(goal Goal) Token {
+	ch := t.consume()
+	switch {
+	case ch == -1:
+		return NewToken(TokenKindEOF, t.line, t.column)
+	case isDecimalDigit(ch) || (ch == '.' && isDecimalDigit(t.peek())):
+		return t.resolveNumericLiteral(ch)
+	case ch == '"' || ch == '\'':
+		return t.resolveStringLiteral(ch)
+	case ch == '`':
+		return t.resolveTemplate(true)
+	case ch == '}' && goal.templateTail():
+		if len(t.braces) > 0 {
+			t.braces = t.braces[:len(t.braces)-1]
+		}
+		return t.resolveTemplate(false)
+	case ch == '/' && goal.regExp():
+		return t.resolveRegularExpressionLiteral()
+	case isPunctuatorStart(ch):
+		token := t.resolvePunctuator(ch)
+		switch token.Kind {
+		case TokenKindLeftBrace:
+			t.braces = append(t.braces, false)
+		case TokenKindRightBrace:
+			if len(t.braces) > 0 {
+				t.braces = t.braces[:len(t.braces)-1]
+			}
+		case TokenKindLeftParen:
+			t.parens = append(t.parens, t.previous == TokenKindIf || t.previous == TokenKindFor || t.previous == TokenKindWhile)
+		case TokenKindRightParen:
+			t.controlParen = false
+			if len(t.parens) > 0 {
+				t.controlParen = t.parens[len(t.parens)-1]
+				t.parens = t.parens[:len(t.parens)-1]
+			}
+		}
+		return token
+	default:
+		return t.resolveIdentifierName(ch)
+	}
 }
 
+// The source text is decoded as UTF-8, one code point at a time; offsets are
+// byte offsets into the text.
+// https://tc39.es/ecma262/#sec-source-text
 func (t *Tokenizer) peek() rune {
-	if t.current >= len(t.text) {
+	ch, _ := t.decode()
+	return ch
+}
+
+func (t *Tokenizer) consume() rune {
+	ch, size := t.decode()
+	if size == 0 {
 		return -1
 	}
+	t.current += size
+
+	// A <CR><LF> sequence is a single line terminator, counted at the <LF>.
+	// https://tc39.es/ecma262/#sec-line-terminators
+	switch {
+	case ch == '\r' && t.peek() == '\n':
+		t.column++
+	case isLineTerminator(ch):
+		t.line++
+		t.column = 0
+	case ch >= 0x10000:
+		t.column += 2
+	default:
+		t.column++
+	}
 
-	return rune(t.text[t.current])
+	return ch
 }
 
-func (t *Tokenizer) consume() rune {
+func (t *Tokenizer) decode() (rune, int) {
+	t.fill(utf8.UTFMax)
 	if t.current >= len(t.text) {
-		return -1
+		return -1, 0
 	}
 
-	ch := rune(t.text[t.current])
-	t.current += 1
-
-	// TODO (c.floyd): This should probably use line separators?
-	if ch == '\n' {
-		t.column = 0
-		t.line += 1
-	} else {
-		t.column += 1
+	if ch := t.text[t.current]; ch < utf8.RuneSelf {
+		return rune(ch), 1
 	}
 
-	return ch
+	ch, size := utf8.DecodeRune(t.text[t.current:])
+	if ch == utf8.RuneError && size == 1 {
+		panic("invalid UTF-8 in source text")
+	}
+	return ch, size
 }
 
 // https://tc39.es/ecma262/#sec-punctuators
 func (t *Tokenizer) resolvePunctuator(start rune) Token {
 	p := punctuator[start]
 	for next, ok := p.match(t.peek()); ok; next, ok = p.match(t.peek()) {
+		// ?. followed by a decimal digit is a conditional operator and a
+		// numeric literal instead.
+		// https://tc39.es/ecma262/#prod-OptionalChainingPunctuator
+		t.fill(2)
+		if next.token == TokenKindQuestionPeriod && t.current+1 < len(t.text) && isDecimalDigit(rune(t.text[t.current+1])) {
+			break
+		}
+
 		t.consume()
 		p = next
 	}
 	return NewToken(p.token, t.line, t.column)
 }
 
-func (t *Tokenizer) resolveBuffer(buffer string) (Token, bool) {
-	if len(buffer) == 0 {
-		return Token{}, false
+// resolveIdentifierName scans an identifier name or a private name. Keywords
+// keep their text, since they may still be used as property names, and a word
+// spelled with escape sequences is never a keyword.
+// https://tc39.es/ecma262/#sec-names-and-keywords
+func (t *Tokenizer) resolveIdentifierName(ch rune) Token {
+	// https://tc39.es/ecma262/#prod-PrivateIdentifier
+	private := ch == '#'
+	if private {
+		ch = t.consume()
 	}
 
-	line, column := t.line, t.column
+	var name strings.Builder
+	escaped := false
+	for {
+		if ch == '\\' {
+			if t.consume() != 'u' {
+				panic("invalid Unicode escape sequence")
+			}
+			ch = rune(t.consumeCodePoint())
+			escaped = true
+		}
 
-	if buffer == "function" {
-		return NewToken(TokenKindFunction, line, column), true
-	}
+		if (name.Len() == 0 && !isIdentifierStart(ch)) || !isIdentifierPart(ch) {
+			if private && name.Len() == 0 {
+				panic("invalid private identifier")
+			}
+			panic(fmt.Sprintf("unexpected character %q", ch))
+		}
+		name.WriteRune(ch)
 
-	if buffer == "var" {
-		return NewToken(TokenKindVar, line, column), true
+		if next := t.peek(); next != '\\' && !isIdentifierPart(next) {
+			break
+		}
+		ch = t.consume()
 	}
 
-	if buffer == "return" {
-		return NewToken(TokenKindReturn, line, column), true
+	if private {
+		return NewTokenWithValue(TokenKindPrivateIdentifier, t.line, t.column, "#"+name.String())
 	}
 
-	if buffer == "if" {
-		return NewToken(TokenKindIf, line, column), true
+	token := NewToken(TokenKindIdentifier, t.line, t.column)
+	if !escaped {
+		token = t.resolveWord(name.String())
 	}
+	token.Value = name.String()
+	token.Escaped = escaped
+	return token
+}
 
-	if buffer == "for" {
-		return NewToken(TokenKindFor, line, column), true
-	}
+// keywords are the reserved words by their text, except await and yield,
+// which are reserved only where the grammar makes them operators.
+// https://tc39.es/ecma262/#prod-ReservedWord
+var keywords = map[string]TokenKind{
+	"break":      TokenKindBreak,
+	"case":       TokenKindCase,
+	"catch":      TokenKindCatch,
+	"class":      TokenKindClass,
+	"const":      TokenKindConst,
+	"continue":   TokenKindContinue,
+	"debugger":   TokenKindDebugger,
+	"default":    TokenKindDefault,
+	"delete":     TokenKindDelete,
+	"do":         TokenKindDo,
+	"else":       TokenKindElse,
+	"enum":       TokenKindEnum,
+	"export":     TokenKindExport,
+	"extends":    TokenKindExtends,
+	"false":      TokenKindFalse,
+	"finally":    TokenKindFinally,
+	"for":        TokenKindFor,
+	"function":   TokenKindFunction,
+	"if":         TokenKindIf,
+	"import":     TokenKindImport,
+	"in":         TokenKindIn,
+	"instanceof": TokenKindInstanceof,
+	"new":        TokenKindNew,
+	"null":       TokenKindNull,
+	"return":     TokenKindReturn,
+	"super":      TokenKindSuper,
+	"switch":     TokenKindSwitch,
+	"this":       TokenKindThis,
+	"throw":      TokenKindThrow,
+	"true":       TokenKindTrue,
+	"try":        TokenKindTry,
+	"typeof":     TokenKindTypeof,
+	"var":        TokenKindVar,
+	"void":       TokenKindVoid,
+	"while":      TokenKindWhile,
+	"with":       TokenKindWith,
+}
 
-	if _, err := strconv.Atoi(buffer); err == nil {
-		return NewTokenWithValue(TokenKindIntLiteral, line, column, buffer), true
-	}
+// contextualKeywords are identifiers that have a special meaning in some
+// syntactic contexts, where they must not contain escape sequences.
+// https://tc39.es/ecma262/#sec-keywords-and-reserved-words
+var contextualKeywords = map[string]bool{
+	"as":     true,
+	"async":  true,
+	"await":  true,
+	"from":   true,
+	"get":    true,
+	"let":    true,
+	"of":     true,
+	"set":    true,
+	"static": true,
+	"yield":  true,
+}
+
+// IsReservedWord reports whether a name is a reserved word, which cannot be
+// used as an identifier even when spelled with escape sequences.
+func IsReservedWord(name string) bool {
+	_, ok := keywords[name]
+	return ok
+}
+
+// IsContextualKeyword reports whether a name is a contextual keyword.
+func IsContextualKeyword(name string) bool {
+	return contextualKeywords[name]
+}
+
+// IsContextual reports whether the token is the contextual keyword, written
+// without escape sequences.
+func (t Token) IsContextual(keyword string) bool {
+	return t.Kind == TokenKindIdentifier && !t.Escaped && t.Value == keyword
+}
 
-	return NewTokenWithValue(TokenKindIdentifier, line, column, buffer), true
+func (t *Tokenizer) resolveWord(buffer string) Token {
+	if kind, ok := keywords[buffer]; ok {
+		return NewToken(kind, t.line, t.column)
+	}
+	return NewTokenWithValue(TokenKindIdentifier, t.line, t.column, buffer)
 }
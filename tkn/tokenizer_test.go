@@ -0,0 +1,46 @@
+package tkn
+
+import "testing"
+
+func TestTokenSpans(t *testing.T) {
+	src := "a = '😀é'\r\n  bc\u2028d /* x\n */ e;"
+
+	type span struct {
+		raw          string
+		start, end   int
+		line, column int
+		newline      bool
+	}
+	want := []span{
+		{raw: "a", start: 0, end: 1, line: 1, column: 1},
+		{raw: "=", start: 2, end: 3, line: 1, column: 3},
+		{raw: "'😀é'", start: 4, end: 12, line: 1, column: 5},
+		{raw: "bc", start: 16, end: 18, line: 2, column: 3, newline: true},
+		{raw: "d", start: 21, end: 22, line: 3, column: 1, newline: true},
+		{raw: "e", start: 32, end: 33, line: 4, column: 5, newline: true},
+		{raw: ";", start: 33, end: 34, line: 4, column: 6},
+		{raw: "", start: 34, end: 34, line: 4, column: 7},
+	}
+
+	tokenizer := Tokenizer{}
+	tokens := tokenizer.Tokenize(src)
+	if len(tokens) != len(want) {
+		t.Fatalf("got %d tokens, want %d", len(tokens), len(want))
+	}
+	for idx, token := range tokens {
+		got := span{
+			raw:     token.Raw,
+			start:   token.Start,
+			end:     token.End,
+			line:    token.Location.Line,
+			column:  token.Location.Column,
+			newline: token.NewlineBefore,
+		}
+		if got != want[idx] {
+			t.Errorf("token %d: got %+v, want %+v", idx, got, want[idx])
+		}
+		if src[token.Start:token.End] != token.Raw {
+			t.Errorf("token %d: raw %q is not the source text %q", idx, token.Raw, src[token.Start:token.End])
+		}
+	}
+}
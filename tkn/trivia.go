@@ -0,0 +1,138 @@
+// Comments and White Space
+// https://tc39.es/ecma262/#sec-comments
+// https://tc39.es/ecma262/#sec-white-space
+
+package tkn
+
+import "bytes"
+
+type TriviaKind int
+
+const (
+	TriviaKindWhitespace TriviaKind = iota
+	TriviaKindLineTerminator
+	TriviaKindSingleLineComment
+	TriviaKindMultiLineComment
+	TriviaKindHashbangComment
+)
+
+func (tk TriviaKind) String() string {
+	switch tk {
+	case TriviaKindWhitespace:
+		return "Whitespace"
+	case TriviaKindLineTerminator:
+		return "LineTerminator"
+	case TriviaKindSingleLineComment:
+		return "SingleLineComment"
+	case TriviaKindMultiLineComment:
+		return "MultiLineComment"
+	case TriviaKindHashbangComment:
+		return "HashbangComment"
+	default:
+		return "Unknown"
+	}
+}
+
+// IsComment reports whether the kind is a comment.
+func (tk TriviaKind) IsComment() bool {
+	return tk == TriviaKindSingleLineComment || tk == TriviaKindMultiLineComment || tk == TriviaKindHashbangComment
+}
+
+// Trivia is source text between tokens, which the syntactic grammar ignores.
+// Start and End are its byte offsets, and Raw its text.
+type Trivia struct {
+	Kind       TriviaKind
+	Start, End int
+	Raw        string
+}
+
+// Option configures a tokenizer.
+type Option func(t *Tokenizer)
+
+// WithTrivia attaches the trivia around each token to it. The trivia up to the
+// end of the line of a token is its trailing trivia, and the rest the leading
+// trivia of the next token, so the source text is the concatenation of the
+// leading trivia, raw text and trailing trivia of all tokens.
+func WithTrivia() Option {
+	return func(t *Tokenizer) {
+		t.trivia = true
+	}
+}
+
+// scanTrivia consumes the trivia before the next token, which is returned
+// when the tokenizer keeps trivia, and reports whether it contains a line
+// terminator. Trailing trivia ends before the first line terminator and before
+// a comment that contains one.
+func (t *Tokenizer) scanTrivia(trailing bool) (trivia []Trivia, newline bool) {
+	for {
+		start, line, column := t.current, t.line, t.column
+
+		var kind TriviaKind
+		switch ch := t.peek(); {
+		case t.base+t.current == 0 && t.lookingAt("#!"):
+			// https://tc39.es/ecma262/#prod-HashbangComment
+			t.consumeLine()
+			kind = TriviaKindHashbangComment
+		case isLineTerminator(ch):
+			if trailing {
+				return trivia, newline
+			}
+
+			if t.consume() == '\r' && t.peek() == '\n' {
+				t.consume()
+			}
+			kind, newline = TriviaKindLineTerminator, true
+		case isWhitespace(ch):
+			for ch := t.peek(); isWhitespace(ch) && !isLineTerminator(ch); ch = t.peek() {
+				t.consume()
+			}
+			kind = TriviaKindWhitespace
+		case t.lookingAt("//"):
+			// https://tc39.es/ecma262/#prod-SingleLineComment
+			t.consumeLine()
+			kind = TriviaKindSingleLineComment
+		case t.lookingAt("/*"):
+			// A multi-line comment containing a line terminator separates
+			// the tokens around it like one.
+			// https://tc39.es/ecma262/#prod-MultiLineComment
+			t.consume()
+			t.consume()
+			multiline := false
+			for !t.lookingAt("*/") {
+				ch := t.consume()
+				if ch == -1 {
+					panic("unterminated comment")
+				}
+				multiline = multiline || isLineTerminator(ch)
+			}
+			t.consume()
+			t.consume()
+
+			if trailing && multiline {
+				t.current, t.line, t.column = start, line, column
+				return trivia, newline
+			}
+			kind, newline = TriviaKindMultiLineComment, newline || multiline
+		default:
+			return trivia, newline
+		}
+
+		if t.trivia {
+			raw := string(t.text[start:t.current])
+			trivia = append(trivia, Trivia{Kind: kind, Start: t.base + start, End: t.base + t.current, Raw: raw})
+		}
+	}
+}
+
+// consumeLine consumes the rest of the line, up to the line terminator.
+func (t *Tokenizer) consumeLine() {
+	for ch := t.peek(); ch != -1 && !isLineTerminator(ch); ch = t.peek() {
+		t.consume()
+	}
+}
+
+// lookingAt reports whether the source text continues with s.
+func (t *Tokenizer) lookingAt(s string) bool {
+	t.fill(len(s))
+	return bytes.HasPrefix(t.text[t.current:], []byte(s))
+}
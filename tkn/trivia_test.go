@@ -0,0 +1,70 @@
+package tkn
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestTriviaRoundTrip(t *testing.T) {
+	sources := []string{
+		"#!/usr/bin/env node\nvar a = 1 // one\n",
+		"/* leading */ a /* inline */ + b; // trailing\r\n\r\n  // own line\r\nc\r\n",
+		"f(/* multi\nline */ x)\t  y",
+		"`a${ b /* in */ }c` / 2 /re/g",
+		"   ",
+		"",
+	}
+
+	for _, src := range sources {
+		tokenizers := map[string]*Tokenizer{
+			"bytes":  NewTokenizerBytes([]byte(src), WithTrivia()),
+			"stream": NewTokenizer(iotest.OneByteReader(strings.NewReader(src)), WithTrivia()),
+		}
+		for name, tokenizer := range tokenizers {
+			var b strings.Builder
+			for {
+				token, err := tokenizer.Next()
+				if err != nil {
+					t.Fatalf("%s %q: %v", name, src, err)
+				}
+
+				for _, trivia := range token.LeadingTrivia {
+					b.WriteString(trivia.Raw)
+				}
+				b.WriteString(token.Raw)
+				for _, trivia := range token.TrailingTrivia {
+					b.WriteString(trivia.Raw)
+				}
+				if token.Kind == TokenKindEOF {
+					break
+				}
+			}
+
+			if b.String() != src {
+				t.Errorf("%s: got %q, want %q", name, b.String(), src)
+			}
+		}
+	}
+}
+
+func TestTriviaKinds(t *testing.T) {
+	tokenizer := Tokenizer{}
+	tokenizer.trivia = true
+	tokens := tokenizer.Tokenize("#!x\n/* a */ b // c\n")
+
+	var got []string
+	for _, token := range tokens {
+		for _, trivia := range token.LeadingTrivia {
+			got = append(got, "<"+trivia.Kind.String())
+		}
+		for _, trivia := range token.TrailingTrivia {
+			got = append(got, ">"+trivia.Kind.String())
+		}
+	}
+
+	want := "<HashbangComment <LineTerminator <MultiLineComment <Whitespace >Whitespace >SingleLineComment <LineTerminator"
+	if strings.Join(got, " ") != want {
+		t.Errorf("got %q, want %q", strings.Join(got, " "), want)
+	}
+}